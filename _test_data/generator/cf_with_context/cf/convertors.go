@@ -0,0 +1,16 @@
+package cf
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+)
+
+func ConvertStringToInt(_ context.Context, from string) (int, error) {
+	return strconv.Atoi(from)
+}
+
+func ConvertStringToDecimal(_ context.Context, from string) decimal.Decimal {
+	return decimal.RequireFromString(from)
+}
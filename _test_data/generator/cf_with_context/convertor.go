@@ -0,0 +1,36 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package cf_with_context is a generated datamapper package.
+package cf_with_context
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/generator/cf_with_context/cf"
+)
+
+// ConvertFromToTo convert From by tag map to To by tag map
+func ConvertFromToTo(ctx context.Context, from From) (To, error) {
+	fromID, err := cf.ConvertStringToInt(ctx, from.ID)
+	if err != nil {
+		return To{}, fmt.Errorf("convert From.ID -> To.ID failed: %w", err)
+	}
+
+	fromItems := make([]int, 0, len(from.Items))
+	for _, item := range from.Items {
+		res, err := cf.ConvertStringToInt(ctx, item)
+		if err != nil {
+			return To{}, fmt.Errorf("convert From.Items -> To.Items failed: %w", err)
+		}
+
+		fromItems = append(fromItems, res)
+	}
+
+	return To{
+		ID:     fromID,
+		Amount: cf.ConvertStringToDecimal(ctx, from.Amount),
+		Items:  fromItems,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package cf_with_context
+
+import "github.com/shopspring/decimal"
+
+type From struct {
+	ID     string   `map:"id"`
+	Amount string   `map:"amount"`
+	Items  []string `map:"items"`
+}
+
+type To struct {
+	ID     int             `map:"id"`
+	Amount decimal.Decimal `map:"amount"`
+	Items  []int           `map:"items"`
+}
@@ -0,0 +1,10 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package direct_conversion is a generated datamapper package.
+package direct_conversion
+
+// ConvertFromToTo convert From by tag map to To by tag map
+func ConvertFromToTo(from From) To {
+	return To(from)
+}
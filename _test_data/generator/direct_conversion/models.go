@@ -0,0 +1,21 @@
+package direct_conversion
+
+import "time"
+
+type Item struct {
+	Value int
+}
+
+type From struct {
+	ID        int       `map:"id" json:"id"`
+	Name      *string   `map:"name" json:"name"`
+	Items     []Item    `map:"items" json:"items"`
+	CreatedAt time.Time `map:"created_at" json:"created_at"`
+}
+
+type To struct {
+	ID        int       `map:"id" db:"id"`
+	Name      *string   `map:"name" db:"name"`
+	Items     []Item    `map:"items" db:"items"`
+	CreatedAt time.Time `map:"created_at" db:"created_at"`
+}
@@ -0,0 +1,15 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package direct_conversion_with_pointers is a generated datamapper package.
+package direct_conversion_with_pointers
+
+// ConvertFromToTo convert *From by tag map to *To by tag map
+func ConvertFromToTo(from *From) *To {
+	if from == nil {
+		return nil
+	}
+
+	converted := To(*from)
+	return &converted
+}
@@ -6,7 +6,5 @@ package without_imports
 
 // ConvertFromToTo convert From by tag map to To by tag map
 func ConvertFromToTo(from From) To {
-	return To{
-		Name: from.Name,
-	}
+	return To(from)
 }
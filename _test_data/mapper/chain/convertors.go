@@ -0,0 +1,25 @@
+package chain
+
+import "errors"
+
+var ErrEmptyCode = errors.New("empty code")
+
+func ConvertUserIDToInt64(from UserID) int64 {
+	return from.Value
+}
+
+func ConvertInt64ToUserID(from int64) UserID {
+	return UserID{Value: from}
+}
+
+func ConvertStringToCode(from string) (Code, error) {
+	if from == "" {
+		return "", ErrEmptyCode
+	}
+
+	return Code(from), nil
+}
+
+func ConvertCodeToString(from Code) string {
+	return string(from)
+}
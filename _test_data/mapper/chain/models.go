@@ -0,0 +1,19 @@
+package chain
+
+type UserID struct {
+	Value int64
+}
+
+type Code string
+
+type User struct {
+	ID   UserID `map:"id"`
+	Code int64  `map:"code"`
+	Name string `map:"name"`
+}
+
+type UserDTO struct {
+	ID   string `map:"id"`
+	Code Code   `map:"code"`
+	Name string `map:"name"`
+}
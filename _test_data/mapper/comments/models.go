@@ -0,0 +1,18 @@
+package comments
+
+// DATAMAPPER convert from DTO:dto:json ../../generated/mapper/dto_convertor.go
+// DATAMAPPER convert to and from *DAO:dao:db ../../generated/mapper/dao_convertor.go
+type Model struct {
+	ID   int    `dto:"id" dao:"id"`
+	Name string `dto:"name" dao:"name"`
+}
+
+type DAO struct {
+	ID   string `db:"id"`
+	Name string `db:"name"`
+}
+
+type DTO struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
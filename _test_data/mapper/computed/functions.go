@@ -0,0 +1,17 @@
+package computed
+
+import "errors"
+
+var ErrEmptyCurrency = errors.New("empty currency")
+
+func FullName(firstName, lastName string) string {
+	return firstName + " " + lastName
+}
+
+func NewMoney(amount int64, currency string) (Money, error) {
+	if currency == "" {
+		return Money{}, ErrEmptyCurrency
+	}
+
+	return Money{Amount: amount, Currency: currency}, nil
+}
@@ -0,0 +1,20 @@
+package computed
+
+type Person struct {
+	ID        int64  `map:"id"`
+	FirstName string `map:"first_name"`
+	LastName  string `map:"last_name"`
+	Amount    int64  `map:"amount"`
+	Currency  string `map:"currency"`
+}
+
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+type Profile struct {
+	ID       int64  `map:"id"`
+	FullName string `map:"full_name"`
+	Balance  Money  `map:"balance"`
+}
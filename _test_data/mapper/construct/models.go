@@ -0,0 +1,66 @@
+package construct
+
+import (
+	"errors"
+	"strings"
+)
+
+type Address struct {
+	City   string `map:"city"`
+	Street string `map:"street"`
+}
+
+type User struct {
+	ID      int64    `map:"id"`
+	Name    string   `map:"name"`
+	Email   string   `map:"email"`
+	Age     int      `map:"age"`
+	Address Address  `map:"address"`
+	Tags    []string `map:"tags"`
+}
+
+type Location struct {
+	city   string `map:"city"`
+	street string `map:"street"`
+}
+
+func NewLocation(city, street string) Location {
+	return Location{
+		city:   city,
+		street: street,
+	}
+}
+
+type Customer struct {
+	id       string   `map:"id"`
+	name     string   `map:"name"`
+	email    string   `map:"email"`
+	age      int      `map:"age"`
+	Location Location `map:"address"`
+	Tags     []string `map:"tags"`
+}
+
+func NewCustomer(id string, fullName string) (*Customer, error) {
+	if id == "" {
+		return nil, errors.New("empty id")
+	}
+
+	return &Customer{
+		id:   id,
+		name: fullName,
+	}, nil
+}
+
+func (c *Customer) SetEmail(email string) error {
+	if !strings.Contains(email, "@") {
+		return errors.New("invalid email")
+	}
+
+	c.email = email
+
+	return nil
+}
+
+func (c *Customer) SetAge(age int) {
+	c.age = age
+}
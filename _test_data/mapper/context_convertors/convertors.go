@@ -0,0 +1,11 @@
+package context_convertors
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+)
+
+func ConvertDecimalToString(_ context.Context, from decimal.Decimal) string {
+	return from.String()
+}
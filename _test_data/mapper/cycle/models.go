@@ -0,0 +1,27 @@
+package cycle
+
+type User struct {
+	ID         int         `map:"id"`
+	Name       string      `map:"name"`
+	Manager    *User       `map:"manager"`
+	Department *Department `map:"department"`
+}
+
+type Department struct {
+	Title string `map:"title"`
+	Head  *User  `map:"head"`
+	Staff []User `map:"staff"`
+}
+
+type UserDTO struct {
+	ID         string         `map:"id"`
+	Name       string         `map:"name"`
+	Manager    *UserDTO       `map:"manager"`
+	Department *DepartmentDTO `map:"department"`
+}
+
+type DepartmentDTO struct {
+	Title string    `map:"title"`
+	Head  *UserDTO  `map:"head"`
+	Staff []UserDTO `map:"staff"`
+}
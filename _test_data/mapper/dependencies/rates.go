@@ -0,0 +1,15 @@
+package dependencies
+
+import "github.com/shopspring/decimal"
+
+type Rates struct {
+	Rate decimal.Decimal
+}
+
+func (r *Rates) ConvertDecimalToString(from decimal.Decimal) string {
+	return from.Mul(r.Rate).String()
+}
+
+func (r *Rates) Rated(from decimal.Decimal, precision int32) decimal.Decimal {
+	return from.Mul(r.Rate).Round(precision)
+}
@@ -0,0 +1,14 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Event struct {
+	ID      uuid.UUID       `map:"id" json:"id"`
+	Created time.Time       `map:"created" json:"created"`
+	Amount  decimal.Decimal `map:"amount" json:"amount"`
+}
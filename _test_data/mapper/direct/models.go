@@ -0,0 +1,40 @@
+package direct
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Address struct {
+	City   string `map:"city"`
+	Street string `map:"street"`
+}
+
+type AddressDTO struct {
+	City   string `map:"city" json:"city"`
+	Street string `map:"street" json:"street"`
+}
+
+type User struct {
+	ID       int64     `map:"id"`
+	Name     string    `map:"name"`
+	Address  Address   `map:"address"`
+	Previous []Address `map:"previous"`
+	Billing  *Address  `map:"billing"`
+}
+
+type UserDTO struct {
+	ID       string       `map:"id"`
+	Name     string       `map:"name"`
+	Address  AddressDTO   `map:"address"`
+	Previous []AddressDTO `map:"previous"`
+	Billing  *AddressDTO  `map:"billing"`
+}
+
+type Event struct {
+	ID      uuid.UUID       `map:"id"`
+	Created time.Time       `map:"created"`
+	Amount  decimal.Decimal `map:"amount"`
+}
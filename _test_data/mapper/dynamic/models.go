@@ -0,0 +1,18 @@
+package dynamic
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+type Event struct {
+	ID     uuid.UUID        `attr:"id"`
+	Name   string           `attr:"name"`
+	Count  int              `attr:"count"`
+	Amount *decimal.Decimal `attr:"amount"`
+	Tags   []string         `attr:"tags"`
+	Level  int8             `attr:"level"`
+	Scores []int            `attr:"scores"`
+	Extra  []any            `attr:"extra"`
+	Local  bool
+}
@@ -0,0 +1,53 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/numeric"
+	"github.com/underbek/datamapper/converts"
+	"github.com/underbek/datamapper/converts/checked"
+)
+
+// ConvertNumericPaymentToNumericPaymentDTO convert numeric.Payment by tag checked to numeric.PaymentDTO by tag checked
+func ConvertNumericPaymentToNumericPaymentDTO(from numeric.Payment) (numeric.PaymentDTO, error) {
+	fromID, err := checked.ConvertOrderedToOrdered[int64, int32](from.ID)
+	if err != nil {
+		return numeric.PaymentDTO{}, fmt.Errorf("convert Payment.ID -> PaymentDTO.ID failed: %w", err)
+	}
+
+	fromAmount, err := checked.ConvertDecimalToNumeric[int64](from.Amount)
+	if err != nil {
+		return numeric.PaymentDTO{}, fmt.Errorf("convert Payment.Amount -> PaymentDTO.Amount failed: %w", err)
+	}
+
+	fromRate, err := checked.ConvertOrderedToOrdered[float64, float32](from.Rate)
+	if err != nil {
+		return numeric.PaymentDTO{}, fmt.Errorf("convert Payment.Rate -> PaymentDTO.Rate failed: %w", err)
+	}
+
+	return numeric.PaymentDTO{
+		ID:     fromID,
+		Amount: fromAmount,
+		Count:  converts.ConvertOrderedToOrdered[int8, int64](from.Count),
+		Rate:   fromRate,
+	}, nil
+}
+
+// ConvertNumericPaymentDTOToNumericPayment convert numeric.PaymentDTO by tag checked to numeric.Payment by tag checked
+func ConvertNumericPaymentDTOToNumericPayment(from numeric.PaymentDTO) (numeric.Payment, error) {
+	fromCount, err := checked.ConvertOrderedToOrdered[int64, int8](from.Count)
+	if err != nil {
+		return numeric.Payment{}, fmt.Errorf("convert PaymentDTO.Count -> Payment.Count failed: %w", err)
+	}
+
+	return numeric.Payment{
+		ID:     converts.ConvertOrderedToOrdered[int32, int64](from.ID),
+		Amount: converts.ConvertIntegerToDecimal(from.Amount),
+		Count:  fromCount,
+		Rate:   converts.ConvertOrderedToOrdered[float32, float64](from.Rate),
+	}, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/numeric"
+	"github.com/underbek/datamapper/_test_data/mapper/numeric/convertors"
+	"github.com/underbek/datamapper/converts"
+	"github.com/underbek/datamapper/converts/checked"
+)
+
+// ConvertNumericPaymentToNumericPaymentDTO convert numeric.Payment by tag checked to numeric.PaymentDTO by tag checked
+func ConvertNumericPaymentToNumericPaymentDTO(from numeric.Payment) (numeric.PaymentDTO, error) {
+	fromAmount, err := checked.ConvertDecimalToNumeric[int64](from.Amount)
+	if err != nil {
+		return numeric.PaymentDTO{}, fmt.Errorf("convert Payment.Amount -> PaymentDTO.Amount failed: %w", err)
+	}
+
+	fromRate, err := checked.ConvertOrderedToOrdered[float64, float32](from.Rate)
+	if err != nil {
+		return numeric.PaymentDTO{}, fmt.Errorf("convert Payment.Rate -> PaymentDTO.Rate failed: %w", err)
+	}
+
+	return numeric.PaymentDTO{
+		ID:     convertors.ClampInt64ToInt32(from.ID),
+		Amount: fromAmount,
+		Count:  converts.ConvertOrderedToOrdered[int8, int64](from.Count),
+		Rate:   fromRate,
+	}, nil
+}
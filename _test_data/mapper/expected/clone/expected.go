@@ -0,0 +1,62 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"github.com/underbek/datamapper/_test_data/mapper/tree"
+	"github.com/underbek/datamapper/_test_data/mapper/tree/labels"
+)
+
+// CloneTreeMeta deep copies tree.Meta
+func CloneTreeMeta(from tree.Meta) tree.Meta {
+	res := from
+
+	if from.Tags != nil {
+		res.Tags = make([]string, len(from.Tags))
+		copy(res.Tags, from.Tags)
+	}
+
+	if from.Options != nil {
+		res.Options = make(map[string]tree.Option, len(from.Options))
+		for k2, v3 := range from.Options {
+			res.Options[k2] = CloneTreeOption(v3)
+		}
+	}
+
+	if from.Attributes != nil {
+		res.Attributes = make(tree.Attributes, len(from.Attributes))
+		for k4, v5 := range from.Attributes {
+			res.Attributes[k4] = CloneTreeOption(v5)
+		}
+	}
+
+	res.Label = CloneLabelsLabel(from.Label)
+
+	return res
+}
+
+// CloneTreeOption deep copies tree.Option
+func CloneTreeOption(from tree.Option) tree.Option {
+	res := from
+
+	if from.Values != nil {
+		res.Values = make([]int, len(from.Values))
+		copy(res.Values, from.Values)
+	}
+
+	return res
+}
+
+// CloneLabelsLabel deep copies labels.Label
+func CloneLabelsLabel(from labels.Label) labels.Label {
+	res := from
+
+	if from.Values != nil {
+		res.Values = make([]string, len(from.Values))
+		copy(res.Values, from.Values)
+	}
+
+	return res
+}
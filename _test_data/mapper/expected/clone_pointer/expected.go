@@ -0,0 +1,176 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/underbek/datamapper/_test_data/mapper/tree"
+	"github.com/underbek/datamapper/_test_data/mapper/tree/labels"
+)
+
+// CloneTreeNode deep copies tree.Node by pointer
+func CloneTreeNode(from *tree.Node) *tree.Node {
+	if from == nil {
+		return nil
+	}
+
+	res := new(tree.Node)
+	*res = cloneTreeNode(*from, map[any]any{from: res})
+	return res
+}
+
+// cloneTreeNode deep copies tree.Node
+func cloneTreeNode(from tree.Node, visited map[any]any) tree.Node {
+	res := from
+
+	if from.Parent != nil {
+		if cached1, ok := visited[from.Parent]; ok {
+			res.Parent = cached1.(*tree.Node)
+		} else {
+			v2 := new(tree.Node)
+			visited[from.Parent] = v2
+			*v2 = cloneTreeNode(*from.Parent, visited)
+			res.Parent = v2
+		}
+	}
+
+	if from.Children != nil {
+		res.Children = make([]*tree.Node, len(from.Children))
+		copy(res.Children, from.Children)
+		for i3 := range from.Children {
+			if from.Children[i3] != nil {
+				if cached4, ok := visited[from.Children[i3]]; ok {
+					res.Children[i3] = cached4.(*tree.Node)
+				} else {
+					v5 := new(tree.Node)
+					visited[from.Children[i3]] = v5
+					*v5 = cloneTreeNode(*from.Children[i3], visited)
+					res.Children[i3] = v5
+				}
+			}
+		}
+	}
+
+	if from.Labels != nil {
+		res.Labels = make(map[string][]string, len(from.Labels))
+		for k6, v7 := range from.Labels {
+			item8 := v7
+			if v7 != nil {
+				item8 = make([]string, len(v7))
+				copy(item8, v7)
+			}
+			res.Labels[k6] = item8
+		}
+	}
+
+	res.Meta = cloneTreeMeta(from.Meta, visited)
+
+	for i10 := range from.Scores {
+		if from.Scores[i10] != nil {
+			v11 := *from.Scores[i10]
+			res.Scores[i10] = &v11
+		}
+	}
+
+	if from.Amount != nil {
+		v12 := *from.Amount
+		res.Amount = &v12
+	}
+
+	if from.Tags != nil {
+		res.Tags = make(tree.Tags, len(from.Tags))
+		copy(res.Tags, from.Tags)
+	}
+
+	if from.Headers != nil {
+		res.Headers = make(http.Header, len(from.Headers))
+		for k14, v15 := range from.Headers {
+			item16 := v15
+			if v15 != nil {
+				item16 = make([]string, len(v15))
+				copy(item16, v15)
+			}
+			res.Headers[k14] = item16
+		}
+	}
+
+	if from.Address != nil {
+		res.Address = make(net.IP, len(from.Address))
+		copy(res.Address, from.Address)
+	}
+
+	if from.Siblings != nil {
+		res.Siblings = make(tree.Nodes, len(from.Siblings))
+		copy(res.Siblings, from.Siblings)
+		for i19 := range from.Siblings {
+			if from.Siblings[i19] != nil {
+				if cached20, ok := visited[from.Siblings[i19]]; ok {
+					res.Siblings[i19] = cached20.(*tree.Node)
+				} else {
+					v21 := new(tree.Node)
+					visited[from.Siblings[i19]] = v21
+					*v21 = cloneTreeNode(*from.Siblings[i19], visited)
+					res.Siblings[i19] = v21
+				}
+			}
+		}
+	}
+
+	return res
+}
+
+// cloneTreeMeta deep copies tree.Meta
+func cloneTreeMeta(from tree.Meta, visited map[any]any) tree.Meta {
+	res := from
+
+	if from.Tags != nil {
+		res.Tags = make([]string, len(from.Tags))
+		copy(res.Tags, from.Tags)
+	}
+
+	if from.Options != nil {
+		res.Options = make(map[string]tree.Option, len(from.Options))
+		for k23, v24 := range from.Options {
+			res.Options[k23] = cloneTreeOption(v24, visited)
+		}
+	}
+
+	if from.Attributes != nil {
+		res.Attributes = make(tree.Attributes, len(from.Attributes))
+		for k25, v26 := range from.Attributes {
+			res.Attributes[k25] = cloneTreeOption(v26, visited)
+		}
+	}
+
+	res.Label = cloneLabelsLabel(from.Label, visited)
+
+	return res
+}
+
+// cloneTreeOption deep copies tree.Option
+func cloneTreeOption(from tree.Option, visited map[any]any) tree.Option {
+	res := from
+
+	if from.Values != nil {
+		res.Values = make([]int, len(from.Values))
+		copy(res.Values, from.Values)
+	}
+
+	return res
+}
+
+// cloneLabelsLabel deep copies labels.Label
+func cloneLabelsLabel(from labels.Label, visited map[any]any) labels.Label {
+	res := from
+
+	if from.Values != nil {
+		res.Values = make([]string, len(from.Values))
+		copy(res.Values, from.Values)
+	}
+
+	return res
+}
@@ -0,0 +1,21 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"context"
+
+	"github.com/underbek/datamapper/_test_data/mapper/context_convertors"
+	f "github.com/underbek/datamapper/_test_data/mapper/recursive/from"
+	t "github.com/underbek/datamapper/_test_data/mapper/recursive/to"
+)
+
+// ConvertFAccountToTAccount convert f.Account by tag recursive to t.Account by tag recursive
+func ConvertFAccountToTAccount(ctx context.Context, from f.Account) t.Account {
+	return t.Account{
+		ID:     from.ID,
+		Amount: context_convertors.ConvertDecimalToString(ctx, from.Amount),
+	}
+}
@@ -0,0 +1,115 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"errors"
+	"fmt"
+
+	f "github.com/underbek/datamapper/_test_data/mapper/recursive/from"
+	t "github.com/underbek/datamapper/_test_data/mapper/recursive/to"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertFOrderToTOrder convert f.Order by tag recursive to t.Order by tag recursive
+func ConvertFOrderToTOrder(from f.Order) (t.Order, error) {
+	fromUser, err := ConvertFUserToTUser(from.User)
+	if err != nil {
+		return t.Order{}, fmt.Errorf("convert Order.User -> Order.User failed: %w", err)
+	}
+
+	fromOperations := make([]*t.Operation, 0, len(from.Operations))
+	for _, item := range from.Operations {
+		res := ConvertFOperationToTOperation(item)
+
+		fromOperations = append(fromOperations, &res)
+	}
+
+	return t.Order{
+		ID:         converts.ConvertOrderedToOrdered[int64, int](from.ID),
+		User:       fromUser,
+		Operations: fromOperations,
+	}, nil
+}
+
+// ConvertTOrderToFOrder convert t.Order by tag recursive to f.Order by tag recursive
+func ConvertTOrderToFOrder(from t.Order) (f.Order, error) {
+	fromUser, err := ConvertTUserToFUser(from.User)
+	if err != nil {
+		return f.Order{}, fmt.Errorf("convert Order.User -> Order.User failed: %w", err)
+	}
+
+	fromOperations := make([]f.Operation, 0, len(from.Operations))
+	for _, item := range from.Operations {
+		if item == nil {
+			return f.Order{}, errors.New("cannot convert t.Order.Operations -> f.Order.Operations, field is nil")
+		}
+
+		fromOperations = append(fromOperations, ConvertTOperationToFOperation(*item))
+	}
+
+	return f.Order{
+		ID:         converts.ConvertOrderedToOrdered[int, int64](from.ID),
+		User:       fromUser,
+		Operations: fromOperations,
+	}, nil
+}
+
+// OrderMapper is an interface of generated convertors
+type OrderMapper interface {
+	ConvertFOrderToTOrder(from f.Order) (t.Order, error)
+	ConvertTOrderToFOrder(from t.Order) (f.Order, error)
+	ConvertFAccountToTAccount(from f.Account) t.Account
+	ConvertTAccountToFAccount(from t.Account) (f.Account, error)
+	ConvertFUserToTUser(from f.User) (t.User, error)
+	ConvertTUserToFUser(from t.User) (f.User, error)
+	ConvertFOperationToTOperation(from f.Operation) t.Operation
+	ConvertTOperationToFOperation(from t.Operation) f.Operation
+}
+
+// DefaultOrderMapper implements OrderMapper by generated convertors
+type DefaultOrderMapper struct{}
+
+var _ OrderMapper = DefaultOrderMapper{}
+
+// ConvertFOrderToTOrder calls generated convertor ConvertFOrderToTOrder
+func (DefaultOrderMapper) ConvertFOrderToTOrder(from f.Order) (t.Order, error) {
+	return ConvertFOrderToTOrder(from)
+}
+
+// ConvertTOrderToFOrder calls generated convertor ConvertTOrderToFOrder
+func (DefaultOrderMapper) ConvertTOrderToFOrder(from t.Order) (f.Order, error) {
+	return ConvertTOrderToFOrder(from)
+}
+
+// ConvertFAccountToTAccount calls generated convertor ConvertFAccountToTAccount
+func (DefaultOrderMapper) ConvertFAccountToTAccount(from f.Account) t.Account {
+	return ConvertFAccountToTAccount(from)
+}
+
+// ConvertTAccountToFAccount calls generated convertor ConvertTAccountToFAccount
+func (DefaultOrderMapper) ConvertTAccountToFAccount(from t.Account) (f.Account, error) {
+	return ConvertTAccountToFAccount(from)
+}
+
+// ConvertFUserToTUser calls generated convertor ConvertFUserToTUser
+func (DefaultOrderMapper) ConvertFUserToTUser(from f.User) (t.User, error) {
+	return ConvertFUserToTUser(from)
+}
+
+// ConvertTUserToFUser calls generated convertor ConvertTUserToFUser
+func (DefaultOrderMapper) ConvertTUserToFUser(from t.User) (f.User, error) {
+	return ConvertTUserToFUser(from)
+}
+
+// ConvertFOperationToTOperation calls generated convertor ConvertFOperationToTOperation
+func (DefaultOrderMapper) ConvertFOperationToTOperation(from f.Operation) t.Operation {
+	return ConvertFOperationToTOperation(from)
+}
+
+// ConvertTOperationToFOperation calls generated convertor ConvertTOperationToFOperation
+func (DefaultOrderMapper) ConvertTOperationToFOperation(from t.Operation) f.Operation {
+	return ConvertTOperationToFOperation(from)
+}
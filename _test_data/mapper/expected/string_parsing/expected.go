@@ -0,0 +1,59 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/query"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertQueryParamsToQueryFilter convert query.Params by tag query to query.Filter by tag query
+func ConvertQueryParamsToQueryFilter(from query.Params) (query.Filter, error) {
+	fromLimit, err := converts.ConvertStringToUnsigned[uint16](from.Limit)
+	if err != nil {
+		return query.Filter{}, fmt.Errorf("convert Params.Limit -> Filter.Limit failed: %w", err)
+	}
+
+	fromOffset, err := converts.ConvertStringToSigned[int8](from.Offset)
+	if err != nil {
+		return query.Filter{}, fmt.Errorf("convert Params.Offset -> Filter.Offset failed: %w", err)
+	}
+
+	fromScore, err := converts.ConvertStringToFloat[float32](from.Score)
+	if err != nil {
+		return query.Filter{}, fmt.Errorf("convert Params.Score -> Filter.Score failed: %w", err)
+	}
+
+	fromActive, err := converts.ConvertStringToBool(from.Active)
+	if err != nil {
+		return query.Filter{}, fmt.Errorf("convert Params.Active -> Filter.Active failed: %w", err)
+	}
+
+	fromPoint, err := converts.ConvertStringToComplex[complex64](from.Point)
+	if err != nil {
+		return query.Filter{}, fmt.Errorf("convert Params.Point -> Filter.Point failed: %w", err)
+	}
+
+	return query.Filter{
+		Limit:  fromLimit,
+		Offset: fromOffset,
+		Score:  fromScore,
+		Active: fromActive,
+		Point:  fromPoint,
+	}, nil
+}
+
+// ConvertQueryFilterToQueryParams convert query.Filter by tag query to query.Params by tag query
+func ConvertQueryFilterToQueryParams(from query.Filter) query.Params {
+	return query.Params{
+		Limit:  converts.ConvertNumericToString(from.Limit),
+		Offset: converts.ConvertNumericToString(from.Offset),
+		Score:  converts.ConvertNumericToString(from.Score),
+		Active: converts.ConvertBoolToString(from.Active),
+		Point:  converts.ConvertComplexToString(from.Point),
+	}
+}
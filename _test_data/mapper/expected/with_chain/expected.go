@@ -0,0 +1,90 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/chain"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertChainUserIDToString convert chain.UserID to string by chain ConvertUserIDToInt64 -> ConvertNumericToString
+func ConvertChainUserIDToString(from chain.UserID) string {
+	step1 := chain.ConvertUserIDToInt64(from)
+
+	step2 := converts.ConvertNumericToString(step1)
+
+	return step2
+}
+
+// ConvertInt64ToChainCode convert int64 to chain.Code by chain ConvertNumericToString -> ConvertStringToCode
+func ConvertInt64ToChainCode(from int64) (res chain.Code, err error) {
+	step1 := converts.ConvertNumericToString(from)
+
+	step2, err := chain.ConvertStringToCode(step1)
+	if err != nil {
+		return res, fmt.Errorf("convert string -> chain.Code by ConvertStringToCode failed: %w", err)
+	}
+
+	return step2, nil
+}
+
+// ConvertChainUserToChainUserDTO convert chain.User by tag map to chain.UserDTO by tag map
+func ConvertChainUserToChainUserDTO(from chain.User) (chain.UserDTO, error) {
+	fromCode, err := ConvertInt64ToChainCode(from.Code)
+	if err != nil {
+		return chain.UserDTO{}, fmt.Errorf("convert User.Code -> UserDTO.Code failed: %w", err)
+	}
+
+	return chain.UserDTO{
+		ID:   ConvertChainUserIDToString(from.ID),
+		Code: fromCode,
+		Name: from.Name,
+	}, nil
+}
+
+// ConvertStringToChainUserID convert string to chain.UserID by chain ConvertStringToSigned -> ConvertInt64ToUserID
+func ConvertStringToChainUserID(from string) (res chain.UserID, err error) {
+	step1, err := converts.ConvertStringToSigned[int64](from)
+	if err != nil {
+		return res, fmt.Errorf("convert string -> int64 by ConvertStringToSigned failed: %w", err)
+	}
+
+	step2 := chain.ConvertInt64ToUserID(step1)
+
+	return step2, nil
+}
+
+// ConvertChainCodeToInt64 convert chain.Code to int64 by chain ConvertCodeToString -> ConvertStringToSigned
+func ConvertChainCodeToInt64(from chain.Code) (res int64, err error) {
+	step1 := chain.ConvertCodeToString(from)
+
+	step2, err := converts.ConvertStringToSigned[int64](step1)
+	if err != nil {
+		return res, fmt.Errorf("convert string -> int64 by ConvertStringToSigned failed: %w", err)
+	}
+
+	return step2, nil
+}
+
+// ConvertChainUserDTOToChainUser convert chain.UserDTO by tag map to chain.User by tag map
+func ConvertChainUserDTOToChainUser(from chain.UserDTO) (chain.User, error) {
+	fromID, err := ConvertStringToChainUserID(from.ID)
+	if err != nil {
+		return chain.User{}, fmt.Errorf("convert UserDTO.ID -> User.ID failed: %w", err)
+	}
+
+	fromCode, err := ConvertChainCodeToInt64(from.Code)
+	if err != nil {
+		return chain.User{}, fmt.Errorf("convert UserDTO.Code -> User.Code failed: %w", err)
+	}
+
+	return chain.User{
+		ID:   fromID,
+		Code: fromCode,
+		Name: from.Name,
+	}, nil
+}
@@ -0,0 +1,38 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/comments"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertCommentsModelToCommentsDAO convert comments.Model by tag dao to *comments.DAO by tag db
+func ConvertCommentsModelToCommentsDAO(from comments.Model) *comments.DAO {
+	return &comments.DAO{
+		ID:   converts.ConvertNumericToString(from.ID),
+		Name: from.Name,
+	}
+}
+
+// ConvertCommentsDAOToCommentsModel convert *comments.DAO by tag db to comments.Model by tag dao
+func ConvertCommentsDAOToCommentsModel(from *comments.DAO) (comments.Model, error) {
+	if from == nil {
+		return comments.Model{}, errors.New("DAO is nil")
+	}
+
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return comments.Model{}, fmt.Errorf("convert DAO.ID -> Model.ID failed: %w", err)
+	}
+
+	return comments.Model{
+		ID:   fromID,
+		Name: from.Name,
+	}, nil
+}
@@ -0,0 +1,25 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/comments"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertCommentsDTOToCommentsModel convert comments.DTO by tag json to comments.Model by tag dto
+func ConvertCommentsDTOToCommentsModel(from comments.DTO) (comments.Model, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return comments.Model{}, fmt.Errorf("convert DTO.ID -> Model.ID failed: %w", err)
+	}
+
+	return comments.Model{
+		ID:   fromID,
+		Name: from.Name,
+	}, nil
+}
@@ -0,0 +1,25 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/computed"
+)
+
+// ConvertComputedPersonToComputedProfile convert computed.Person by tag map to computed.Profile by tag map
+func ConvertComputedPersonToComputedProfile(from computed.Person) (computed.Profile, error) {
+	computedBalance, err := computed.NewMoney(from.Amount, from.Currency)
+	if err != nil {
+		return computed.Profile{}, fmt.Errorf("compute Profile.Balance by NewMoney failed: %w", err)
+	}
+
+	return computed.Profile{
+		ID:       from.ID,
+		FullName: computed.FullName(from.FirstName, from.LastName),
+		Balance:  computedBalance,
+	}, nil
+}
@@ -0,0 +1,14 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import "github.com/underbek/datamapper/_test_data/mapper/construct"
+
+// ConvertConstructAddressToConstructLocation convert construct.Address by tag map to construct.Location by tag map
+func ConvertConstructAddressToConstructLocation(from construct.Address) construct.Location {
+	res := construct.NewLocation(from.City, from.Street)
+
+	return res
+}
@@ -0,0 +1,31 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/construct"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertConstructUserToConstructCustomer convert construct.User by tag map to construct.Customer by tag map
+func ConvertConstructUserToConstructCustomer(from construct.User) (construct.Customer, error) {
+	constructed, err := construct.NewCustomer(converts.ConvertNumericToString(from.ID), from.Name)
+	if err != nil {
+		return construct.Customer{}, fmt.Errorf("construct Customer by NewCustomer failed: %w", err)
+	}
+
+	res := *constructed
+
+	if err := res.SetEmail(from.Email); err != nil {
+		return construct.Customer{}, fmt.Errorf("construct Customer by SetEmail failed: %w", err)
+	}
+	res.SetAge(from.Age)
+	res.Location = ConvertConstructAddressToConstructLocation(from.Address)
+	res.Tags = from.Tags
+
+	return res, nil
+}
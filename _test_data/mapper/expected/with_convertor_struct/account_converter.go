@@ -0,0 +1,18 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	f "github.com/underbek/datamapper/_test_data/mapper/recursive/from"
+	t "github.com/underbek/datamapper/_test_data/mapper/recursive/to"
+)
+
+// ConvertFAccountToTAccount convert f.Account by tag recursive to t.Account by tag recursive
+func (c *OrderConvertor) ConvertFAccountToTAccount(from f.Account) t.Account {
+	return t.Account{
+		ID:     from.ID,
+		Amount: c.rates.ConvertDecimalToString(from.Amount),
+	}
+}
@@ -0,0 +1,44 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	f "github.com/underbek/datamapper/_test_data/mapper/recursive/from"
+	t "github.com/underbek/datamapper/_test_data/mapper/recursive/to"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertFOrderToTOrder convert f.Order by tag recursive to t.Order by tag recursive
+func (c *OrderConvertor) ConvertFOrderToTOrder(from f.Order) (t.Order, error) {
+	fromUser, err := c.ConvertFUserToTUser(from.User)
+	if err != nil {
+		return t.Order{}, fmt.Errorf("convert Order.User -> Order.User failed: %w", err)
+	}
+
+	fromOperations := make([]*t.Operation, 0, len(from.Operations))
+	for _, item := range from.Operations {
+		res := c.ConvertFOperationToTOperation(item)
+
+		fromOperations = append(fromOperations, &res)
+	}
+
+	return t.Order{
+		ID:         converts.ConvertOrderedToOrdered[int64, int](from.ID),
+		User:       fromUser,
+		Operations: fromOperations,
+	}, nil
+}
+
+// OrderMapper is an interface of generated convertors
+type OrderMapper interface {
+	ConvertFOrderToTOrder(from f.Order) (t.Order, error)
+	ConvertFAccountToTAccount(from f.Account) t.Account
+	ConvertFUserToTUser(from f.User) (t.User, error)
+	ConvertFOperationToTOperation(from f.Operation) t.Operation
+}
+
+var _ OrderMapper = (*OrderConvertor)(nil)
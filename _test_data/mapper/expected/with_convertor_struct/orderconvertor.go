@@ -0,0 +1,19 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import "github.com/underbek/datamapper/_test_data/mapper/dependencies"
+
+// OrderConvertor contains convertors with conversion functions dependencies
+type OrderConvertor struct {
+	rates *dependencies.Rates
+}
+
+// NewOrderConvertor creates OrderConvertor with conversion functions dependencies
+func NewOrderConvertor(rates *dependencies.Rates) *OrderConvertor {
+	return &OrderConvertor{
+		rates: rates,
+	}
+}
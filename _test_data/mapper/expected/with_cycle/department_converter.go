@@ -0,0 +1,60 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/cycle"
+)
+
+// ConvertCycleDepartmentToCycleDepartmentDTO convert cycle.Department by tag map to cycle.DepartmentDTO by tag map
+func ConvertCycleDepartmentToCycleDepartmentDTO(from cycle.Department) cycle.DepartmentDTO {
+	var fromHead *cycle.UserDTO
+	if from.Head != nil {
+		res := ConvertCycleUserToCycleUserDTO(*from.Head)
+		fromHead = &res
+	}
+
+	fromStaff := make([]cycle.UserDTO, 0, len(from.Staff))
+	for _, item := range from.Staff {
+		fromStaff = append(fromStaff, ConvertCycleUserToCycleUserDTO(item))
+	}
+
+	return cycle.DepartmentDTO{
+		Title: from.Title,
+		Head:  fromHead,
+		Staff: fromStaff,
+	}
+}
+
+// ConvertCycleDepartmentDTOToCycleDepartment convert cycle.DepartmentDTO by tag map to cycle.Department by tag map
+func ConvertCycleDepartmentDTOToCycleDepartment(from cycle.DepartmentDTO) (cycle.Department, error) {
+	var fromHead *cycle.User
+	if from.Head != nil {
+		res, err := ConvertCycleUserDTOToCycleUser(*from.Head)
+		if err != nil {
+			return cycle.Department{}, fmt.Errorf("convert DepartmentDTO.Head -> Department.Head failed: %w", err)
+		}
+
+		fromHead = &res
+	}
+
+	fromStaff := make([]cycle.User, 0, len(from.Staff))
+	for _, item := range from.Staff {
+		res, err := ConvertCycleUserDTOToCycleUser(item)
+		if err != nil {
+			return cycle.Department{}, fmt.Errorf("convert DepartmentDTO.Staff -> Department.Staff failed: %w", err)
+		}
+
+		fromStaff = append(fromStaff, res)
+	}
+
+	return cycle.Department{
+		Title: from.Title,
+		Head:  fromHead,
+		Staff: fromStaff,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/cycle"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertCycleUserToCycleUserDTO convert cycle.User by tag map to cycle.UserDTO by tag map
+func ConvertCycleUserToCycleUserDTO(from cycle.User) cycle.UserDTO {
+	var fromManager *cycle.UserDTO
+	if from.Manager != nil {
+		res := ConvertCycleUserToCycleUserDTO(*from.Manager)
+		fromManager = &res
+	}
+
+	return cycle.UserDTO{
+		ID:         converts.ConvertNumericToString(from.ID),
+		Name:       from.Name,
+		Manager:    fromManager,
+		Department: ConvertCycleDepartmentToCycleDepartmentDTO(from.Department),
+	}
+}
+
+// ConvertCycleUserDTOToCycleUser convert cycle.UserDTO by tag map to cycle.User by tag map
+func ConvertCycleUserDTOToCycleUser(from cycle.UserDTO) (cycle.User, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return cycle.User{}, fmt.Errorf("convert UserDTO.ID -> User.ID failed: %w", err)
+	}
+
+	var fromManager *cycle.User
+	if from.Manager != nil {
+		res, err := ConvertCycleUserDTOToCycleUser(*from.Manager)
+		if err != nil {
+			return cycle.User{}, fmt.Errorf("convert UserDTO.Manager -> User.Manager failed: %w", err)
+		}
+
+		fromManager = &res
+	}
+
+	fromDepartment, err := ConvertCycleDepartmentDTOToCycleDepartment(from.Department)
+	if err != nil {
+		return cycle.User{}, fmt.Errorf("convert UserDTO.Department -> User.Department failed: %w", err)
+	}
+
+	return cycle.User{
+		ID:         fromID,
+		Name:       from.Name,
+		Manager:    fromManager,
+		Department: fromDepartment,
+	}, nil
+}
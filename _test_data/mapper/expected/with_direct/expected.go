@@ -0,0 +1,61 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/direct"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertDirectUserToDirectUserDTO convert direct.User by tag map to direct.UserDTO by tag map
+func ConvertDirectUserToDirectUserDTO(from direct.User) direct.UserDTO {
+	fromPrevious := make([]direct.AddressDTO, 0, len(from.Previous))
+	for _, item := range from.Previous {
+		fromPrevious = append(fromPrevious, direct.AddressDTO(item))
+	}
+
+	var fromBilling *direct.AddressDTO
+	if from.Billing != nil {
+		res := direct.AddressDTO(*from.Billing)
+		fromBilling = &res
+	}
+
+	return direct.UserDTO{
+		ID:       converts.ConvertNumericToString(from.ID),
+		Name:     from.Name,
+		Address:  direct.AddressDTO(from.Address),
+		Previous: fromPrevious,
+		Billing:  fromBilling,
+	}
+}
+
+// ConvertDirectUserDTOToDirectUser convert direct.UserDTO by tag map to direct.User by tag map
+func ConvertDirectUserDTOToDirectUser(from direct.UserDTO) (direct.User, error) {
+	fromID, err := converts.ConvertStringToSigned[int64](from.ID)
+	if err != nil {
+		return direct.User{}, fmt.Errorf("convert UserDTO.ID -> User.ID failed: %w", err)
+	}
+
+	fromPrevious := make([]direct.Address, 0, len(from.Previous))
+	for _, item := range from.Previous {
+		fromPrevious = append(fromPrevious, direct.Address(item))
+	}
+
+	var fromBilling *direct.Address
+	if from.Billing != nil {
+		res := direct.Address(*from.Billing)
+		fromBilling = &res
+	}
+
+	return direct.User{
+		ID:       fromID,
+		Name:     from.Name,
+		Address:  direct.Address(from.Address),
+		Previous: fromPrevious,
+		Billing:  fromBilling,
+	}, nil
+}
@@ -0,0 +1,20 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"github.com/underbek/datamapper/_test_data/mapper/direct"
+	"github.com/underbek/datamapper/_test_data/mapper/direct/dto"
+)
+
+// ConvertDirectEventToDtoEvent convert direct.Event by tag map to dto.Event by tag map
+func ConvertDirectEventToDtoEvent(from direct.Event) dto.Event {
+	return dto.Event(from)
+}
+
+// ConvertDtoEventToDirectEvent convert dto.Event by tag map to direct.Event by tag map
+func ConvertDtoEventToDirectEvent(from dto.Event) direct.Event {
+	return direct.Event(from)
+}
@@ -0,0 +1,41 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package generated is a generated datamapper package.
+package generated
+
+import (
+	"fmt"
+	"myapp/domain"
+	"myapp/transport"
+
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertDomainOrderToTransportOrder convert domain.Order by tag map to transport.Order by tag map
+func ConvertDomainOrderToTransportOrder(from domain.Order) transport.Order {
+	return transport.Order{
+		ID:        converts.ConvertNumericToString(from.ID),
+		Price:     ConvertMoneyMoneyToTransportmoneyMoney(from.Price),
+		CreatedAt: from.CreatedAt,
+	}
+}
+
+// ConvertTransportOrderToDomainOrder convert transport.Order by tag map to domain.Order by tag map
+func ConvertTransportOrderToDomainOrder(from transport.Order) (domain.Order, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("convert Order.ID -> Order.ID failed: %w", err)
+	}
+
+	fromPrice, err := ConvertTransportmoneyMoneyToMoneyMoney(from.Price)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("convert Order.Price -> Order.Price failed: %w", err)
+	}
+
+	return domain.Order{
+		ID:        fromID,
+		Price:     fromPrice,
+		CreatedAt: from.CreatedAt,
+	}, nil
+}
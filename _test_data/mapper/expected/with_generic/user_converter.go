@@ -0,0 +1,33 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/generic"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertGenericUserToGenericUserDTO convert generic.User by tag map to generic.UserDTO by tag map
+func ConvertGenericUserToGenericUserDTO(from generic.User) generic.UserDTO {
+	return generic.UserDTO{
+		ID:   converts.ConvertNumericToString(from.ID),
+		Name: from.Name,
+	}
+}
+
+// ConvertGenericUserDTOToGenericUser convert generic.UserDTO by tag map to generic.User by tag map
+func ConvertGenericUserDTOToGenericUser(from generic.UserDTO) (generic.User, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return generic.User{}, fmt.Errorf("convert UserDTO.ID -> User.ID failed: %w", err)
+	}
+
+	return generic.User{
+		ID:   fromID,
+		Name: from.Name,
+	}, nil
+}
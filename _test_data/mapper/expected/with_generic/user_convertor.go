@@ -0,0 +1,32 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/generic"
+)
+
+// ConvertGenericUsersToGenericUsersDTO convert generic.Users by tag map to generic.UsersDTO by tag map
+func ConvertGenericUsersToGenericUsersDTO(from generic.Users) generic.UsersDTO {
+	return generic.UsersDTO{
+		Page:   ConvertGenericPageOfGenericUserToGenericPageOfGenericUserDTO(from.Page),
+		Filter: from.Filter,
+	}
+}
+
+// ConvertGenericUsersDTOToGenericUsers convert generic.UsersDTO by tag map to generic.Users by tag map
+func ConvertGenericUsersDTOToGenericUsers(from generic.UsersDTO) (generic.Users, error) {
+	fromPage, err := ConvertGenericPageOfGenericUserDTOToGenericPageOfGenericUser(from.Page)
+	if err != nil {
+		return generic.Users{}, fmt.Errorf("convert UsersDTO.Page -> Users.Page failed: %w", err)
+	}
+
+	return generic.Users{
+		Page:   fromPage,
+		Filter: from.Filter,
+	}, nil
+}
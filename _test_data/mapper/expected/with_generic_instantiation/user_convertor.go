@@ -0,0 +1,60 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/generic"
+)
+
+// ConvertGenericPageOfGenericUserToGenericPageOfGenericUserDTO convert generic.Page[generic.User] by tag map to generic.Page[generic.UserDTO] by tag map
+func ConvertGenericPageOfGenericUserToGenericPageOfGenericUserDTO(from generic.Page[generic.User]) generic.Page[generic.UserDTO] {
+	fromItems := make([]generic.UserDTO, 0, len(from.Items))
+	for _, item := range from.Items {
+		fromItems = append(fromItems, ConvertGenericUserToGenericUserDTO(item))
+	}
+
+	var fromFirst *generic.UserDTO
+	if from.First != nil {
+		res := ConvertGenericUserToGenericUserDTO(*from.First)
+		fromFirst = &res
+	}
+
+	return generic.Page[generic.UserDTO]{
+		Items: fromItems,
+		First: fromFirst,
+		Total: from.Total,
+	}
+}
+
+// ConvertGenericPageOfGenericUserDTOToGenericPageOfGenericUser convert generic.Page[generic.UserDTO] by tag map to generic.Page[generic.User] by tag map
+func ConvertGenericPageOfGenericUserDTOToGenericPageOfGenericUser(from generic.Page[generic.UserDTO]) (generic.Page[generic.User], error) {
+	fromItems := make([]generic.User, 0, len(from.Items))
+	for _, item := range from.Items {
+		res, err := ConvertGenericUserDTOToGenericUser(item)
+		if err != nil {
+			return generic.Page[generic.User]{}, fmt.Errorf("convert Page.Items -> Page.Items failed: %w", err)
+		}
+
+		fromItems = append(fromItems, res)
+	}
+
+	var fromFirst *generic.User
+	if from.First != nil {
+		res, err := ConvertGenericUserDTOToGenericUser(*from.First)
+		if err != nil {
+			return generic.Page[generic.User]{}, fmt.Errorf("convert Page.First -> Page.First failed: %w", err)
+		}
+
+		fromFirst = &res
+	}
+
+	return generic.Page[generic.User]{
+		Items: fromItems,
+		First: fromFirst,
+		Total: from.Total,
+	}, nil
+}
@@ -0,0 +1,19 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import "github.com/underbek/datamapper/_test_data/mapper/getters"
+
+// ConvertGettersAddressToGettersAddressDTO convert *getters.Address by tag map to *getters.AddressDTO by tag map
+func ConvertGettersAddressToGettersAddressDTO(from *getters.Address) *getters.AddressDTO {
+	if from == nil {
+		return nil
+	}
+
+	return &getters.AddressDTO{
+		City:   from.GetCity(),
+		Street: from.GetStreet(),
+	}
+}
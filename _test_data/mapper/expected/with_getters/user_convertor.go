@@ -0,0 +1,20 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"github.com/underbek/datamapper/_test_data/mapper/getters"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertGettersUserToGettersUserDTO convert *getters.User by tag map to getters.UserDTO by tag map
+func ConvertGettersUserToGettersUserDTO(from *getters.User) getters.UserDTO {
+	return getters.UserDTO{
+		ID:      converts.ConvertNumericToString(from.GetID()),
+		Name:    from.GetName(),
+		Address: ConvertGettersAddressToGettersAddressDTO(from.GetAddress()),
+		Tags:    from.GetTags(),
+	}
+}
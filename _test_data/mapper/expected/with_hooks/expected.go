@@ -0,0 +1,70 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/convertors"
+	"github.com/underbek/datamapper/_test_data/mapper/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/hooks"
+	"github.com/underbek/datamapper/_test_data/mapper/transport"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertTransportUserToDomainUser convert transport.User by tag map to domain.User by tag map
+func ConvertTransportUserToDomainUser(from transport.User) (domain.User, error) {
+	if err := hooks.BeforeConvertTransportUserToDomainUser(from); err != nil {
+		return domain.User{}, err
+	}
+
+	fromAge, err := converts.ConvertStringToDecimal(from.Age)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("convert User.Age -> User.Age failed: %w", err)
+	}
+
+	var fromChildCount *int
+	if from.ChildCount != nil {
+		res, err := converts.ConvertStringToSigned[int](*from.ChildCount)
+		if err != nil {
+			return domain.User{}, fmt.Errorf("convert User.ChildCount -> User.ChildCount failed: %w", err)
+		}
+
+		fromChildCount = &res
+	}
+
+	res := domain.User{
+		ID:         convertors.CustomUUIDToInteger[int](from.UUID),
+		Name:       from.Name,
+		Age:        fromAge,
+		ChildCount: fromChildCount,
+	}
+
+	if err := hooks.NormalizeUserName(from, &res); err != nil {
+		return domain.User{}, err
+	}
+
+	return res, nil
+}
+
+// ConvertDomainUserToTransportUser convert domain.User by tag map to transport.User by tag map
+func ConvertDomainUserToTransportUser(from domain.User) transport.User {
+	var fromChildCount *string
+	if from.ChildCount != nil {
+		res := converts.ConvertNumericToString(*from.ChildCount)
+		fromChildCount = &res
+	}
+
+	res := transport.User{
+		UUID:       convertors.CustomIntegerToUUID(from.ID),
+		Name:       from.Name,
+		Age:        converts.ConvertDecimalToString(from.Age),
+		ChildCount: fromChildCount,
+	}
+
+	hooks.AfterConvertDomainUserToTransportUser(from, &res)
+
+	return res
+}
@@ -0,0 +1,18 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"github.com/underbek/datamapper/_test_data/mapper/getters"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertGettersProfileToGettersProfileDTO convert getters.Profile by tag map to getters.ProfileDTO by tag map
+func ConvertGettersProfileToGettersProfileDTO(from getters.Profile) getters.ProfileDTO {
+	return getters.ProfileDTO{
+		UserID:   converts.ConvertNumericToString(from.GetUserID()),
+		FullName: from.GetFullName(),
+	}
+}
@@ -0,0 +1,241 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/underbek/datamapper/_test_data/mapper/dynamic"
+	"github.com/underbek/datamapper/converts"
+	"github.com/underbek/datamapper/converts/checked"
+)
+
+// ConvertDynamicEventToMap convert *dynamic.Event by tag attr to map[string]any by tag attr
+func ConvertDynamicEventToMap(from *dynamic.Event) (map[string]any, error) {
+	if from == nil {
+		return nil, errors.New("Event is nil")
+	}
+
+	return map[string]any{
+		"id":     from.ID,
+		"name":   from.Name,
+		"count":  from.Count,
+		"amount": from.Amount,
+		"tags":   from.Tags,
+		"level":  from.Level,
+		"scores": from.Scores,
+		"extra":  from.Extra,
+	}, nil
+}
+
+// ConvertMapToDynamicEvent convert map[string]any to *dynamic.Event by tag attr
+func ConvertMapToDynamicEvent(from map[string]any) (*dynamic.Event, error) {
+	var fromID uuid.UUID
+	if value, ok := from["id"]; ok {
+		switch v := value.(type) {
+		case uuid.UUID:
+			fromID = v
+		case string:
+			res, err := converts.ConvertStringToUUID(v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[id] -> Event.ID failed: %w", err)
+			}
+			fromID = res
+		default:
+			return nil, fmt.Errorf("convert map[id] -> Event.ID failed: unexpected type %T", value)
+		}
+	}
+
+	var fromName string
+	if value, ok := from["name"]; ok {
+		switch v := value.(type) {
+		case string:
+			fromName = v
+		case float64:
+			fromName = converts.ConvertNumericToString(v)
+		case int64:
+			fromName = converts.ConvertNumericToString(v)
+		case int:
+			fromName = converts.ConvertNumericToString(v)
+		case bool:
+			fromName = converts.ConvertBoolToString(v)
+		default:
+			return nil, fmt.Errorf("convert map[name] -> Event.Name failed: unexpected type %T", value)
+		}
+	}
+
+	var fromCount int
+	if value, ok := from["count"]; ok {
+		switch v := value.(type) {
+		case int:
+			fromCount = v
+		case string:
+			res, err := converts.ConvertStringToSigned[int](v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[count] -> Event.Count failed: %w", err)
+			}
+			fromCount = res
+		case float64:
+			res, err := checked.ConvertOrderedToOrdered[float64, int](v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[count] -> Event.Count failed: %w", err)
+			}
+			fromCount = res
+		case int64:
+			fromCount = converts.ConvertOrderedToOrdered[int64, int](v)
+		default:
+			return nil, fmt.Errorf("convert map[count] -> Event.Count failed: unexpected type %T", value)
+		}
+	}
+
+	var fromAmount *decimal.Decimal
+	if value, ok := from["amount"]; ok {
+		switch v := value.(type) {
+		case decimal.Decimal:
+			fromAmount = &v
+		case *decimal.Decimal:
+			fromAmount = v
+		case string:
+			res, err := converts.ConvertStringToDecimal(v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[amount] -> Event.Amount failed: %w", err)
+			}
+			fromAmount = &res
+		case float64:
+			res := converts.ConvertFloatToDecimal(v)
+			fromAmount = &res
+		case int64:
+			res := converts.ConvertIntegerToDecimal(v)
+			fromAmount = &res
+		case int:
+			res := converts.ConvertIntegerToDecimal(v)
+			fromAmount = &res
+		default:
+			return nil, fmt.Errorf("convert map[amount] -> Event.Amount failed: unexpected type %T", value)
+		}
+	}
+
+	var fromTags []string
+	if value, ok := from["tags"]; ok {
+		switch v := value.(type) {
+		case []string:
+			fromTags = v
+		case []any:
+			items := make([]string, 0, len(v))
+			for i, item := range v {
+				switch v := item.(type) {
+				case string:
+					items = append(items, v)
+				case float64:
+					items = append(items, converts.ConvertNumericToString(v))
+				case int64:
+					items = append(items, converts.ConvertNumericToString(v))
+				case int:
+					items = append(items, converts.ConvertNumericToString(v))
+				case bool:
+					items = append(items, converts.ConvertBoolToString(v))
+				default:
+					return nil, fmt.Errorf("convert map[tags][%d] -> Event.Tags failed: unexpected type %T", i, item)
+				}
+			}
+			fromTags = items
+		default:
+			return nil, fmt.Errorf("convert map[tags] -> Event.Tags failed: unexpected type %T", value)
+		}
+	}
+
+	var fromLevel int8
+	if value, ok := from["level"]; ok {
+		switch v := value.(type) {
+		case int8:
+			fromLevel = v
+		case string:
+			res, err := converts.ConvertStringToSigned[int8](v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[level] -> Event.Level failed: %w", err)
+			}
+			fromLevel = res
+		case float64:
+			res, err := checked.ConvertOrderedToOrdered[float64, int8](v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[level] -> Event.Level failed: %w", err)
+			}
+			fromLevel = res
+		case int64:
+			res, err := checked.ConvertOrderedToOrdered[int64, int8](v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[level] -> Event.Level failed: %w", err)
+			}
+			fromLevel = res
+		case int:
+			res, err := checked.ConvertOrderedToOrdered[int, int8](v)
+			if err != nil {
+				return nil, fmt.Errorf("convert map[level] -> Event.Level failed: %w", err)
+			}
+			fromLevel = res
+		default:
+			return nil, fmt.Errorf("convert map[level] -> Event.Level failed: unexpected type %T", value)
+		}
+	}
+
+	var fromScores []int
+	if value, ok := from["scores"]; ok {
+		switch v := value.(type) {
+		case []int:
+			fromScores = v
+		case []any:
+			items := make([]int, 0, len(v))
+			for i, item := range v {
+				switch v := item.(type) {
+				case int:
+					items = append(items, v)
+				case string:
+					res, err := converts.ConvertStringToSigned[int](v)
+					if err != nil {
+						return nil, fmt.Errorf("convert map[scores][%d] -> Event.Scores failed: %w", i, err)
+					}
+					items = append(items, res)
+				case float64:
+					res, err := checked.ConvertOrderedToOrdered[float64, int](v)
+					if err != nil {
+						return nil, fmt.Errorf("convert map[scores][%d] -> Event.Scores failed: %w", i, err)
+					}
+					items = append(items, res)
+				case int64:
+					items = append(items, converts.ConvertOrderedToOrdered[int64, int](v))
+				default:
+					return nil, fmt.Errorf("convert map[scores][%d] -> Event.Scores failed: unexpected type %T", i, item)
+				}
+			}
+			fromScores = items
+		default:
+			return nil, fmt.Errorf("convert map[scores] -> Event.Scores failed: unexpected type %T", value)
+		}
+	}
+
+	var fromExtra []interface{}
+	if value, ok := from["extra"]; ok {
+		switch v := value.(type) {
+		case []interface{}:
+			fromExtra = v
+		default:
+			return nil, fmt.Errorf("convert map[extra] -> Event.Extra failed: unexpected type %T", value)
+		}
+	}
+
+	return &dynamic.Event{
+		ID:     fromID,
+		Name:   fromName,
+		Count:  fromCount,
+		Amount: fromAmount,
+		Tags:   fromTags,
+		Level:  fromLevel,
+		Scores: fromScores,
+		Extra:  fromExtra,
+	}, nil
+}
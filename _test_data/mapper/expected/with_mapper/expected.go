@@ -0,0 +1,76 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/convertors"
+	"github.com/underbek/datamapper/_test_data/mapper/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/transport"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertTransportUserToDomainUser convert transport.User by tag map to domain.User by tag map
+func ConvertTransportUserToDomainUser(from transport.User) (domain.User, error) {
+	fromAge, err := converts.ConvertStringToDecimal(from.Age)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("convert User.Age -> User.Age failed: %w", err)
+	}
+
+	var fromChildCount *int
+	if from.ChildCount != nil {
+		res, err := converts.ConvertStringToSigned[int](*from.ChildCount)
+		if err != nil {
+			return domain.User{}, fmt.Errorf("convert User.ChildCount -> User.ChildCount failed: %w", err)
+		}
+
+		fromChildCount = &res
+	}
+
+	return domain.User{
+		ID:         convertors.CustomUUIDToInteger[int](from.UUID),
+		Name:       from.Name,
+		Age:        fromAge,
+		ChildCount: fromChildCount,
+	}, nil
+}
+
+// ConvertDomainUserToTransportUser convert domain.User by tag map to transport.User by tag map
+func ConvertDomainUserToTransportUser(from domain.User) transport.User {
+	var fromChildCount *string
+	if from.ChildCount != nil {
+		res := converts.ConvertNumericToString(*from.ChildCount)
+		fromChildCount = &res
+	}
+
+	return transport.User{
+		UUID:       convertors.CustomIntegerToUUID(from.ID),
+		Name:       from.Name,
+		Age:        converts.ConvertDecimalToString(from.Age),
+		ChildCount: fromChildCount,
+	}
+}
+
+// UserMapper is an interface of generated convertors
+type UserMapper interface {
+	ConvertTransportUserToDomainUser(from transport.User) (domain.User, error)
+	ConvertDomainUserToTransportUser(from domain.User) transport.User
+}
+
+// DefaultUserMapper implements UserMapper by generated convertors
+type DefaultUserMapper struct{}
+
+var _ UserMapper = DefaultUserMapper{}
+
+// ConvertTransportUserToDomainUser calls generated convertor ConvertTransportUserToDomainUser
+func (DefaultUserMapper) ConvertTransportUserToDomainUser(from transport.User) (domain.User, error) {
+	return ConvertTransportUserToDomainUser(from)
+}
+
+// ConvertDomainUserToTransportUser calls generated convertor ConvertDomainUserToTransportUser
+func (DefaultUserMapper) ConvertDomainUserToTransportUser(from domain.User) transport.User {
+	return ConvertDomainUserToTransportUser(from)
+}
@@ -0,0 +1,26 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"errors"
+
+	"github.com/underbek/datamapper/_test_data/mapper/merge"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertMergeUserAndMergeAccountToMergeProfile convert merge.User by tag map and *merge.Account by tag account to merge.Profile by tag map
+func ConvertMergeUserAndMergeAccountToMergeProfile(user merge.User, account *merge.Account) (merge.Profile, error) {
+	if account == nil {
+		return merge.Profile{}, errors.New("cannot convert Account -> Profile, model is nil")
+	}
+
+	return merge.Profile{
+		ID:        user.ID,
+		Name:      user.Name,
+		AccountID: account.ID,
+		Balance:   converts.ConvertDecimalToString(account.Balance),
+	}, nil
+}
@@ -0,0 +1,15 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import "github.com/underbek/datamapper/_test_data/mapper/merge"
+
+// ConvertMergeUserAndMergeSettingsToMergeAppearance convert merge.User by tag map and merge.Settings by tag map to merge.Appearance by tag map
+func ConvertMergeUserAndMergeSettingsToMergeAppearance(user merge.User, settings merge.Settings) merge.Appearance {
+	return merge.Appearance{
+		Name:  user.Name,
+		Theme: settings.Theme,
+	}
+}
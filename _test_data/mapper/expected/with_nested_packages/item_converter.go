@@ -0,0 +1,46 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/nested/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/nested/domain/money"
+	"github.com/underbek/datamapper/_test_data/mapper/nested/transport"
+	transportmoney "github.com/underbek/datamapper/_test_data/mapper/nested/transport/money"
+)
+
+// ConvertDomainItemToTransportItem convert domain.Item by tag map to transport.Item by tag map
+func ConvertDomainItemToTransportItem(from domain.Item) transport.Item {
+	var fromPrice *transportmoney.Money
+	if from.Price != nil {
+		res := ConvertMoneyMoneyToTransportmoneyMoney(*from.Price)
+		fromPrice = &res
+	}
+
+	return transport.Item{
+		Name:  from.Name,
+		Price: fromPrice,
+	}
+}
+
+// ConvertTransportItemToDomainItem convert transport.Item by tag map to domain.Item by tag map
+func ConvertTransportItemToDomainItem(from transport.Item) (domain.Item, error) {
+	var fromPrice *money.Money
+	if from.Price != nil {
+		res, err := ConvertTransportmoneyMoneyToMoneyMoney(*from.Price)
+		if err != nil {
+			return domain.Item{}, fmt.Errorf("convert Item.Price -> Item.Price failed: %w", err)
+		}
+
+		fromPrice = &res
+	}
+
+	return domain.Item{
+		Name:  from.Name,
+		Price: fromPrice,
+	}, nil
+}
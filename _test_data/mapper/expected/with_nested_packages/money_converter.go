@@ -0,0 +1,34 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/nested/domain/money"
+	transportmoney "github.com/underbek/datamapper/_test_data/mapper/nested/transport/money"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertMoneyMoneyToTransportmoneyMoney convert money.Money by tag map to transportmoney.Money by tag map
+func ConvertMoneyMoneyToTransportmoneyMoney(from money.Money) transportmoney.Money {
+	return transportmoney.Money{
+		Amount:   converts.ConvertNumericToString(from.Amount),
+		Currency: from.Currency,
+	}
+}
+
+// ConvertTransportmoneyMoneyToMoneyMoney convert transportmoney.Money by tag map to money.Money by tag map
+func ConvertTransportmoneyMoneyToMoneyMoney(from transportmoney.Money) (money.Money, error) {
+	fromAmount, err := converts.ConvertStringToSigned[int64](from.Amount)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("convert Money.Amount -> Money.Amount failed: %w", err)
+	}
+
+	return money.Money{
+		Amount:   fromAmount,
+		Currency: from.Currency,
+	}, nil
+}
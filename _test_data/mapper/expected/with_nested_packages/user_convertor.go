@@ -0,0 +1,56 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/nested/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/nested/transport"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertDomainOrderToTransportOrder convert domain.Order by tag map to transport.Order by tag map
+func ConvertDomainOrderToTransportOrder(from domain.Order) transport.Order {
+	fromItems := make([]transport.Item, 0, len(from.Items))
+	for _, item := range from.Items {
+		fromItems = append(fromItems, ConvertDomainItemToTransportItem(item))
+	}
+
+	return transport.Order{
+		ID:    converts.ConvertNumericToString(from.ID),
+		Price: ConvertMoneyMoneyToTransportmoneyMoney(from.Price),
+		Items: fromItems,
+	}
+}
+
+// ConvertTransportOrderToDomainOrder convert transport.Order by tag map to domain.Order by tag map
+func ConvertTransportOrderToDomainOrder(from transport.Order) (domain.Order, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("convert Order.ID -> Order.ID failed: %w", err)
+	}
+
+	fromPrice, err := ConvertTransportmoneyMoneyToMoneyMoney(from.Price)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("convert Order.Price -> Order.Price failed: %w", err)
+	}
+
+	fromItems := make([]domain.Item, 0, len(from.Items))
+	for _, item := range from.Items {
+		res, err := ConvertTransportItemToDomainItem(item)
+		if err != nil {
+			return domain.Order{}, fmt.Errorf("convert Order.Items -> Order.Items failed: %w", err)
+		}
+
+		fromItems = append(fromItems, res)
+	}
+
+	return domain.Order{
+		ID:    fromID,
+		Price: fromPrice,
+		Items: fromItems,
+	}, nil
+}
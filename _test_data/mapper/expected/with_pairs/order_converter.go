@@ -0,0 +1,39 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/pairs/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/pairs/transport"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertDomainOrderToTransportOrderDTO convert domain.Order by tag map to transport.OrderDTO by tag json
+func ConvertDomainOrderToTransportOrderDTO(from domain.Order) transport.OrderDTO {
+	return transport.OrderDTO{
+		ID:     converts.ConvertNumericToString(from.ID),
+		Amount: converts.ConvertNumericToString(from.Amount),
+	}
+}
+
+// ConvertTransportOrderDTOToDomainOrder convert transport.OrderDTO by tag json to domain.Order by tag map
+func ConvertTransportOrderDTOToDomainOrder(from transport.OrderDTO) (domain.Order, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("convert OrderDTO.ID -> Order.ID failed: %w", err)
+	}
+
+	fromAmount, err := converts.ConvertStringToFloat[float64](from.Amount)
+	if err != nil {
+		return domain.Order{}, fmt.Errorf("convert OrderDTO.Amount -> Order.Amount failed: %w", err)
+	}
+
+	return domain.Order{
+		ID:     fromID,
+		Amount: fromAmount,
+	}, nil
+}
@@ -0,0 +1,34 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/underbek/datamapper/_test_data/mapper/pairs/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/pairs/transport"
+	"github.com/underbek/datamapper/converts"
+)
+
+// ConvertDomainUserToTransportUserDTO convert domain.User by tag map to transport.UserDTO by tag json
+func ConvertDomainUserToTransportUserDTO(from domain.User) transport.UserDTO {
+	return transport.UserDTO{
+		ID:   converts.ConvertNumericToString(from.ID),
+		Name: from.Name,
+	}
+}
+
+// ConvertTransportUserDTOToDomainUser convert transport.UserDTO by tag json to domain.User by tag map
+func ConvertTransportUserDTOToDomainUser(from transport.UserDTO) (domain.User, error) {
+	fromID, err := converts.ConvertStringToSigned[int](from.ID)
+	if err != nil {
+		return domain.User{}, fmt.Errorf("convert UserDTO.ID -> User.ID failed: %w", err)
+	}
+
+	return domain.User{
+		ID:   fromID,
+		Name: from.Name,
+	}, nil
+}
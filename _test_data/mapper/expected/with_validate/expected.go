@@ -0,0 +1,32 @@
+// Code generated by datamapper.
+// https://github.com/underbek/datamapper
+
+// Package mapper is a generated datamapper package.
+package mapper
+
+import (
+	"errors"
+
+	"github.com/underbek/datamapper/_test_data/mapper/validate"
+)
+
+// ConvertValidateAccountDTOToValidateAccount convert validate.AccountDTO by tag map to *validate.Account by tag map
+func ConvertValidateAccountDTOToValidateAccount(from validate.AccountDTO) (*validate.Account, error) {
+	converted := validate.Account(from)
+	res := &converted
+
+	if err := res.Validate(); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ConvertValidateAccountToValidateAccountDTO convert *validate.Account by tag map to validate.AccountDTO by tag map
+func ConvertValidateAccountToValidateAccountDTO(from *validate.Account) (validate.AccountDTO, error) {
+	if from == nil {
+		return validate.AccountDTO{}, errors.New("Account is nil")
+	}
+
+	return validate.AccountDTO(*from), nil
+}
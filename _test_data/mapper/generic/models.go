@@ -0,0 +1,32 @@
+package generic
+
+type Page[T any] struct {
+	Items []T `map:"items"`
+	First *T  `map:"first"`
+	Total int `map:"total"`
+}
+
+type Pair[K comparable, V any] struct {
+	Key   K `map:"key"`
+	Value V `map:"value"`
+}
+
+type User struct {
+	ID   int    `map:"id"`
+	Name string `map:"name"`
+}
+
+type UserDTO struct {
+	ID   string `map:"id"`
+	Name string `map:"name"`
+}
+
+type Users struct {
+	Page   Page[User] `map:"page"`
+	Filter string     `map:"filter"`
+}
+
+type UsersDTO struct {
+	Page   Page[UserDTO] `map:"page"`
+	Filter string        `map:"filter"`
+}
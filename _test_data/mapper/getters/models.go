@@ -0,0 +1,84 @@
+package getters
+
+type Address struct {
+	City   string `map:"city"`
+	street string `map:"street"`
+}
+
+func (x *Address) GetCity() string {
+	if x == nil {
+		return ""
+	}
+
+	return x.City
+}
+
+func (x *Address) GetStreet() string {
+	if x == nil {
+		return ""
+	}
+
+	return x.street
+}
+
+type User struct {
+	ID      int64    `map:"id"`
+	Name    string   `map:"name"`
+	Address *Address `map:"address"`
+	Tags    []string `map:"tags"`
+}
+
+func (x *User) GetID() int64 {
+	if x == nil {
+		return 0
+	}
+
+	return x.ID
+}
+
+func (x *User) GetName() string {
+	if x == nil {
+		return ""
+	}
+
+	return x.Name
+}
+
+func (x *User) GetAddress() *Address {
+	if x == nil {
+		return nil
+	}
+
+	return x.Address
+}
+
+func (x *User) GetTags() []string {
+	if x == nil {
+		return nil
+	}
+
+	return x.Tags
+}
+
+type Profile interface {
+	GetUserID() int64
+	GetFullName() string
+	Validate() error
+}
+
+type AddressDTO struct {
+	City   string `map:"city"`
+	Street string `map:"street"`
+}
+
+type UserDTO struct {
+	ID      string      `map:"id"`
+	Name    string      `map:"name"`
+	Address *AddressDTO `map:"address"`
+	Tags    []string    `map:"tags"`
+}
+
+type ProfileDTO struct {
+	UserID   string `map:"user_id"`
+	FullName string `map:"full_name"`
+}
@@ -0,0 +1,28 @@
+package hooks
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/underbek/datamapper/_test_data/mapper/domain"
+	"github.com/underbek/datamapper/_test_data/mapper/transport"
+)
+
+var ErrEmptyName = errors.New("empty name")
+
+func BeforeConvertTransportUserToDomainUser(from transport.User) error {
+	if from.Name == "" {
+		return ErrEmptyName
+	}
+
+	return nil
+}
+
+func AfterConvertDomainUserToTransportUser(from domain.User, to *transport.User) {
+	to.Name = strings.ToUpper(from.Name)
+}
+
+func NormalizeUserName(_ transport.User, to *domain.User) error {
+	to.Name = strings.TrimSpace(to.Name)
+	return nil
+}
@@ -0,0 +1,31 @@
+package merge
+
+import "github.com/shopspring/decimal"
+
+type User struct {
+	ID   int    `map:"id"`
+	Name string `map:"name"`
+}
+
+type Account struct {
+	ID      int             `account:"account_id"`
+	Balance decimal.Decimal `account:"balance"`
+}
+
+type Settings struct {
+	ID    int    `map:"id"`
+	Theme string `map:"theme"`
+}
+
+type Profile struct {
+	ID        int    `map:"id"`
+	Name      string `map:"name"`
+	AccountID int    `map:"account_id"`
+	Balance   string `map:"balance"`
+	Theme     string `map:"theme"`
+}
+
+type Appearance struct {
+	Name  string `map:"name"`
+	Theme string `map:"theme"`
+}
@@ -0,0 +1,13 @@
+package domain
+
+import (
+	"time"
+
+	"myapp/domain/money"
+)
+
+type Order struct {
+	ID        int         `map:"id"`
+	Price     money.Money `map:"price"`
+	CreatedAt time.Time   `map:"created_at"`
+}
@@ -0,0 +1,6 @@
+package money
+
+type Money struct {
+	Amount   int64  `map:"amount"`
+	Currency string `map:"currency"`
+}
@@ -0,0 +1,13 @@
+package transport
+
+import (
+	"time"
+
+	"myapp/transport/money"
+)
+
+type Order struct {
+	ID        string      `map:"id"`
+	Price     money.Money `map:"price"`
+	CreatedAt time.Time   `map:"created_at"`
+}
@@ -0,0 +1,6 @@
+package money
+
+type Money struct {
+	Amount   string `map:"amount"`
+	Currency string `map:"currency"`
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "github.com/underbek/datamapper/_test_data/mapper/nested/domain/money"
+
+type Order struct {
+	ID    int         `map:"id"`
+	Price money.Money `map:"price"`
+	Items []Item      `map:"items"`
+}
+
+type Item struct {
+	Name  string       `map:"name"`
+	Price *money.Money `map:"price"`
+}
@@ -0,0 +1,15 @@
+package convertors
+
+import "math"
+
+func ClampInt64ToInt32(from int64) int32 {
+	if from > math.MaxInt32 {
+		return math.MaxInt32
+	}
+
+	if from < math.MinInt32 {
+		return math.MinInt32
+	}
+
+	return int32(from)
+}
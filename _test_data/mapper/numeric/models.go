@@ -0,0 +1,17 @@
+package numeric
+
+import "github.com/shopspring/decimal"
+
+type Payment struct {
+	ID     int64           `checked:"id"`
+	Amount decimal.Decimal `checked:"amount"`
+	Count  int8            `checked:"count"`
+	Rate   float64         `checked:"rate"`
+}
+
+type PaymentDTO struct {
+	ID     int32   `checked:"id"`
+	Amount int64   `checked:"amount"`
+	Count  int64   `checked:"count"`
+	Rate   float32 `checked:"rate"`
+}
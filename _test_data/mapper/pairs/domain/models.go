@@ -0,0 +1,19 @@
+package domain
+
+type User struct {
+	ID   int    `map:"id"`
+	Name string `map:"name"`
+}
+
+type Order struct {
+	ID     int     `map:"id"`
+	Amount float64 `map:"amount"`
+}
+
+type Audit struct {
+	Action string `map:"action"`
+}
+
+type Settings struct {
+	Theme string `map:"theme"`
+}
@@ -0,0 +1,19 @@
+package transport
+
+type UserDTO struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type OrderDTO struct {
+	ID     string `json:"id"`
+	Amount string `json:"amount"`
+}
+
+type AuditDTO struct {
+	Event string `json:"event"`
+}
+
+type LegacyDTO struct {
+	Code string `json:"code"`
+}
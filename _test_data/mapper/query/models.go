@@ -0,0 +1,17 @@
+package query
+
+type Params struct {
+	Limit  string `query:"limit"`
+	Offset string `query:"offset"`
+	Score  string `query:"score"`
+	Active string `query:"active"`
+	Point  string `query:"point"`
+}
+
+type Filter struct {
+	Limit  uint16    `query:"limit"`
+	Offset int8      `query:"offset"`
+	Score  float32   `query:"score"`
+	Active bool      `query:"active"`
+	Point  complex64 `query:"point"`
+}
@@ -0,0 +1,6 @@
+package labels
+
+type Label struct {
+	Name   string
+	Values []string
+}
@@ -0,0 +1,43 @@
+package tree
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/underbek/datamapper/_test_data/mapper/tree/labels"
+)
+
+type Node struct {
+	ID       int
+	Name     string
+	Parent   *Node
+	Children []*Node
+	Labels   map[string][]string
+	Meta     Meta
+	Scores   [3]*int
+	Created  time.Time
+	Amount   *decimal.Decimal
+	Tags     Tags
+	Headers  http.Header
+	Address  net.IP
+	Siblings Nodes
+}
+
+type Meta struct {
+	Tags       []string
+	Options    map[string]Option
+	Attributes Attributes
+	Label      labels.Label
+}
+
+type Option struct {
+	Values []int
+}
+
+type Tags []string
+
+type Nodes []*Node
+
+type Attributes map[string]Option
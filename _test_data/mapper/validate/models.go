@@ -0,0 +1,23 @@
+package validate
+
+import "errors"
+
+var ErrNegativeBalance = errors.New("negative balance")
+
+type Account struct {
+	ID      int64 `map:"id"`
+	Balance int64 `map:"balance"`
+}
+
+func (a *Account) Validate() error {
+	if a.Balance < 0 {
+		return ErrNegativeBalance
+	}
+
+	return nil
+}
+
+type AccountDTO struct {
+	ID      int64 `map:"id"`
+	Balance int64 `map:"balance"`
+}
@@ -0,0 +1,19 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+func ConvertStringToIntWithContext(_ context.Context, from string) (int, error) {
+	return strconv.Atoi(from)
+}
+
+func ConvertFloatToStringWithContext(_ context.Context, from float64) string {
+	return fmt.Sprint(from)
+}
+
+func ConvertIntToStringWithPrefix(prefix string, from int) string {
+	return prefix + fmt.Sprint(from)
+}
@@ -0,0 +1,26 @@
+package parser
+
+import "strconv"
+
+// ConvertFloat64ToString converts float by directives
+//
+//datamapper:priority 10
+//datamapper:inverse ConvertStringToFloat64
+func ConvertFloat64ToString(from float64) string {
+	return strconv.FormatFloat(from, 'f', 2, 64)
+}
+
+// ConvertStringToFloat64 converts string to float
+//
+//datamapper:lossy
+//datamapper:inverse ConvertFloat64ToString
+func ConvertStringToFloat64(from string) (float64, error) {
+	return strconv.ParseFloat(from, 64)
+}
+
+// FormatBool looks like conversion function but it is a helper
+//
+//datamapper:ignore
+func FormatBool(from bool) string {
+	return strconv.FormatBool(from)
+}
@@ -6,17 +6,30 @@ import (
 	"github.com/underbek/datamapper/parser"
 )
 
-const internalConvertsPackagePath = "github.com/underbek/datamapper/converts"
+const (
+	internalConvertsPackagePath        = "github.com/underbek/datamapper/converts"
+	internalCheckedConvertsPackagePath = "github.com/underbek/datamapper/converts/checked"
+)
 
 func main() {
 	lg := logger.New()
 	funcs, err := parser.ParseConversionFunctionsByPackage(lg, internalConvertsPackagePath)
 	if err != nil {
-		lg.Fatalf("parse internal conversion functions error: %w", err)
+		lg.Fatalf("parse internal conversion functions error: %v", err)
 	}
 
 	err = loader.Save(funcs)
 	if err != nil {
-		lg.Fatalf("parse internal conversion functions error: %w", err)
+		lg.Fatalf("parse internal conversion functions error: %v", err)
+	}
+
+	checkedFuncs, err := parser.ParseConversionFunctionsByPackage(lg, internalCheckedConvertsPackagePath)
+	if err != nil {
+		lg.Fatalf("parse internal checked conversion functions error: %v", err)
+	}
+
+	err = loader.SaveChecked(checkedFuncs)
+	if err != nil {
+		lg.Fatalf("save internal checked conversion functions error: %v", err)
 	}
 }
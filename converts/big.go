@@ -0,0 +1,21 @@
+package converts
+
+import (
+	"fmt"
+	"math/big"
+)
+
+func ConvertBigIntPtrToString(from *big.Int) string {
+	if from == nil {
+		return ""
+	}
+	return from.String()
+}
+
+func ConvertStringToBigIntPtr(from string) (*big.Int, error) {
+	res, ok := new(big.Int).SetString(from, base)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid big integer %q", ErrParse, from)
+	}
+	return res, nil
+}
@@ -0,0 +1,25 @@
+package converts
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func ConvertBytesToString(from []byte) string {
+	return string(from)
+}
+
+func ConvertStringToBytes(from string) []byte {
+	return []byte(from)
+}
+
+func ConvertRawMessageToString(from json.RawMessage) string {
+	return string(from)
+}
+
+func ConvertStringToRawMessage(from string) (json.RawMessage, error) {
+	if !json.Valid([]byte(from)) {
+		return nil, fmt.Errorf("%w: invalid json %q", ErrParse, from)
+	}
+	return json.RawMessage(from), nil
+}
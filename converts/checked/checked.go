@@ -0,0 +1,110 @@
+// Package checked contains error returning variants of narrowing numeric conversions.
+// Functions have the same names and signatures as in converts package except of error result.
+package checked
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+	"github.com/underbek/datamapper/converts/internal/numeric"
+	"golang.org/x/exp/constraints"
+)
+
+var ErrOverflow = errors.New("numeric overflow error")
+
+func ConvertOrderedToOrdered[T, V constraints.Integer | constraints.Float](from T) (V, error) {
+	if isFloat[T]() {
+		value := float64(from)
+		if math.IsNaN(value) {
+			return 0, fmt.Errorf("%w: NaN cannot be converted", ErrOverflow)
+		}
+
+		if isFloat[V]() {
+			return convertFloatToFloat[V](value)
+		}
+
+		if math.Trunc(value) != value {
+			return 0, fmt.Errorf("%w: %v has fractional part", ErrOverflow, from)
+		}
+
+		// out of range float conversion to integer is implementation-specific, so range is checked before it
+		minValue, maxValue := integerRange[V]()
+		if value < minValue || value >= maxValue {
+			return 0, fmt.Errorf("%w: %v is out of range", ErrOverflow, from)
+		}
+	}
+
+	res := V(from)
+	if (from < 0) != (res < 0) {
+		return 0, fmt.Errorf("%w: sign of %v is lost", ErrOverflow, from)
+	}
+
+	if T(res) != from {
+		return 0, fmt.Errorf("%w: %v cannot be represented without loss", ErrOverflow, from)
+	}
+
+	return res, nil
+}
+
+func ConvertDecimalToNumeric[T constraints.Integer | constraints.Float](from decimal.Decimal) (T, error) {
+	if isFloat[T]() {
+		value, _ := from.Float64()
+		if math.IsInf(value, 0) {
+			return 0, fmt.Errorf("%w: %s is out of range", ErrOverflow, from)
+		}
+
+		return convertFloatToFloat[T](value)
+	}
+
+	if !from.IsInteger() {
+		return 0, fmt.Errorf("%w: %s has fractional part", ErrOverflow, from)
+	}
+
+	value := from.BigInt()
+	switch {
+	case value.IsInt64():
+		res := T(value.Int64())
+		if int64(res) != value.Int64() || (res < 0) != (value.Sign() < 0) {
+			return 0, fmt.Errorf("%w: %s is out of range", ErrOverflow, from)
+		}
+
+		return res, nil
+	case value.IsUint64():
+		res := T(value.Uint64())
+		if uint64(res) != value.Uint64() || res < 0 {
+			return 0, fmt.Errorf("%w: %s is out of range", ErrOverflow, from)
+		}
+
+		return res, nil
+	default:
+		return 0, fmt.Errorf("%w: %s is out of range", ErrOverflow, from)
+	}
+}
+
+// convertFloatToFloat converts float keeping infinities, but finite value out of target range is overflow
+func convertFloatToFloat[V constraints.Integer | constraints.Float](value float64) (V, error) {
+	if numeric.BitSize[V]() == 32 && !math.IsInf(value, 0) && math.Abs(value) > math.MaxFloat32 {
+		return 0, fmt.Errorf("%w: %v is out of range", ErrOverflow, value)
+	}
+
+	return V(value), nil
+}
+
+// integerRange returns bounds of integer type as floats: min value and first value greater than max value.
+// Both bounds are powers of two, so they are exact
+func integerRange[V constraints.Integer | constraints.Float]() (float64, float64) {
+	bits := numeric.BitSize[V]()
+	var zero V
+	if zero-1 > 0 {
+		return 0, math.Ldexp(1, bits)
+	}
+
+	return -math.Ldexp(1, bits-1), math.Ldexp(1, bits-1)
+}
+
+func isFloat[T constraints.Integer | constraints.Float]() bool {
+	half := 0.5
+	return T(half) != 0
+}
@@ -0,0 +1,210 @@
+package checked
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ConvertOrderedToOrdered(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  func() (any, error)
+		expected any
+		err      error
+	}{
+		{
+			name:     "int to uint",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[int, uint](42) },
+			expected: uint(42),
+		},
+		{
+			name:    "negative int to uint",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[int, uint](-1) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "large uint64 to int64",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[uint64, int64](math.MaxUint64) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "int64 to int8 overflow",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[int64, int8](128) },
+			err:     ErrOverflow,
+		},
+		{
+			name:     "int64 to int8 min",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[int64, int8](-128) },
+			expected: int8(-128),
+		},
+		{
+			name:     "exact float to int",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[float64, int](-3) },
+			expected: -3,
+		},
+		{
+			name:    "truncating fraction",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, int](1.9) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "negative fraction to uint",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, uint](-0.5) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "NaN to int",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, int](math.NaN()) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "positive infinity to int",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, int64](math.Inf(1)) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "negative infinity to int",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, int64](math.Inf(-1)) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "float out of int64 range",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, int64](math.Ldexp(1, 63)) },
+			err:     ErrOverflow,
+		},
+		{
+			name:     "float of int64 min",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[float64, int64](-math.Ldexp(1, 63)) },
+			expected: int64(math.MinInt64),
+		},
+		{
+			name:    "float out of uint8 range",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, uint8](256) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "max int64 to float64",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[int64, float64](math.MaxInt64) },
+			err:     ErrOverflow,
+		},
+		{
+			name:     "exact int64 to float64",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[int64, float64](1 << 53) },
+			expected: float64(1 << 53),
+		},
+		{
+			name:     "float64 to float32",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[float64, float32](1.5) },
+			expected: float32(1.5),
+		},
+		{
+			name:    "finite float64 overflow of float32",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, float32](1e300) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "negative finite float64 overflow of float32",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, float32](-1e300) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "NaN to float32",
+			convert: func() (any, error) { return ConvertOrderedToOrdered[float64, float32](math.NaN()) },
+			err:     ErrOverflow,
+		},
+		{
+			name:     "infinity to float32",
+			convert:  func() (any, error) { return ConvertOrderedToOrdered[float64, float32](math.Inf(-1)) },
+			expected: float32(math.Inf(-1)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.convert()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
+
+func Test_ConvertDecimalToNumeric(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  func() (any, error)
+		expected any
+		err      error
+	}{
+		{
+			name:     "integer decimal to int",
+			convert:  func() (any, error) { return ConvertDecimalToNumeric[int](decimal.NewFromInt(-7)) },
+			expected: -7,
+		},
+		{
+			name:    "truncating fraction",
+			convert: func() (any, error) { return ConvertDecimalToNumeric[int](decimal.RequireFromString("1.9")) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "negative decimal to uint",
+			convert: func() (any, error) { return ConvertDecimalToNumeric[uint](decimal.NewFromInt(-1)) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "decimal out of int8 range",
+			convert: func() (any, error) { return ConvertDecimalToNumeric[int8](decimal.NewFromInt(300)) },
+			err:     ErrOverflow,
+		},
+		{
+			name: "decimal out of uint64 range",
+			convert: func() (any, error) {
+				return ConvertDecimalToNumeric[uint64](decimal.RequireFromString("18446744073709551616"))
+			},
+			err: ErrOverflow,
+		},
+		{
+			name: "max uint64 decimal",
+			convert: func() (any, error) {
+				return ConvertDecimalToNumeric[uint64](decimal.RequireFromString("18446744073709551615"))
+			},
+			expected: uint64(math.MaxUint64),
+		},
+		{
+			name:     "decimal to float32",
+			convert:  func() (any, error) { return ConvertDecimalToNumeric[float32](decimal.RequireFromString("2.5")) },
+			expected: float32(2.5),
+		},
+		{
+			name:    "finite decimal overflow of float32",
+			convert: func() (any, error) { return ConvertDecimalToNumeric[float32](decimal.New(1, 300)) },
+			err:     ErrOverflow,
+		},
+		{
+			name:    "decimal overflow of float64",
+			convert: func() (any, error) { return ConvertDecimalToNumeric[float64](decimal.New(1, 400)) },
+			err:     ErrOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := tt.convert()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -0,0 +1,5 @@
+package converts
+
+import "errors"
+
+var ErrParse = errors.New("parse error")
@@ -0,0 +1,13 @@
+// Package numeric contains helpers shared by converts and checked packages.
+package numeric
+
+import (
+	"unsafe"
+
+	"golang.org/x/exp/constraints"
+)
+
+// BitSize returns size of numeric type parameter in bits
+func BitSize[T constraints.Integer | constraints.Float | constraints.Complex]() int {
+	return int(unsafe.Sizeof(T(0))) * 8
+}
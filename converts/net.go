@@ -0,0 +1,42 @@
+package converts
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+)
+
+func ConvertAddrToString(from netip.Addr) string {
+	return from.String()
+}
+
+func ConvertStringToAddr(from string) (netip.Addr, error) {
+	return netip.ParseAddr(from)
+}
+
+func ConvertIPToString(from net.IP) string {
+	if from == nil {
+		return ""
+	}
+	return from.String()
+}
+
+func ConvertStringToIP(from string) (net.IP, error) {
+	res := net.ParseIP(from)
+	if res == nil {
+		return nil, fmt.Errorf("%w: invalid IP address %q", ErrParse, from)
+	}
+	return res, nil
+}
+
+func ConvertURLPtrToString(from *url.URL) string {
+	if from == nil {
+		return ""
+	}
+	return from.String()
+}
+
+func ConvertStringToURLPtr(from string) (*url.URL, error) {
+	return url.Parse(from)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/underbek/datamapper/converts/internal/numeric"
 	"golang.org/x/exp/constraints"
 )
 
@@ -17,14 +18,46 @@ func ConvertComplexToString[T constraints.Complex](from T) string {
 	return fmt.Sprint(from)
 }
 
+func ConvertBoolToString(from bool) string {
+	return strconv.FormatBool(from)
+}
+
 func ConvertOrderedToOrdered[T, V constraints.Integer | constraints.Float](from T) V {
 	return V(from)
 }
 
 func ConvertStringToSigned[T constraints.Signed](from string) (T, error) {
-	res, err := strconv.ParseInt(from, base, 0)
+	res, err := strconv.ParseInt(from, base, numeric.BitSize[T]())
+	if err != nil {
+		return 0, err
+	}
+	return T(res), nil
+}
+
+func ConvertStringToUnsigned[T constraints.Unsigned](from string) (T, error) {
+	res, err := strconv.ParseUint(from, base, numeric.BitSize[T]())
+	if err != nil {
+		return 0, err
+	}
+	return T(res), nil
+}
+
+func ConvertStringToFloat[T constraints.Float](from string) (T, error) {
+	res, err := strconv.ParseFloat(from, numeric.BitSize[T]())
 	if err != nil {
 		return 0, err
 	}
 	return T(res), nil
 }
+
+func ConvertStringToComplex[T constraints.Complex](from string) (T, error) {
+	res, err := strconv.ParseComplex(from, numeric.BitSize[T]())
+	if err != nil {
+		return 0, err
+	}
+	return T(res), nil
+}
+
+func ConvertStringToBool(from string) (bool, error) {
+	return strconv.ParseBool(from)
+}
@@ -0,0 +1,182 @@
+package converts
+
+import (
+	"database/sql"
+	"time"
+)
+
+func ConvertNullStringToString(from sql.NullString) string {
+	return from.String
+}
+
+func ConvertNullStringToStringPtr(from sql.NullString) *string {
+	if !from.Valid {
+		return nil
+	}
+	return &from.String
+}
+
+func ConvertStringToNullString(from string) sql.NullString {
+	return sql.NullString{String: from, Valid: true}
+}
+
+func ConvertStringPtrToNullString(from *string) sql.NullString {
+	if from == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *from, Valid: true}
+}
+
+func ConvertNullInt64ToInt64(from sql.NullInt64) int64 {
+	return from.Int64
+}
+
+func ConvertNullInt64ToInt64Ptr(from sql.NullInt64) *int64 {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Int64
+}
+
+func ConvertInt64ToNullInt64(from int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: from, Valid: true}
+}
+
+func ConvertInt64PtrToNullInt64(from *int64) sql.NullInt64 {
+	if from == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *from, Valid: true}
+}
+
+func ConvertNullInt32ToInt32(from sql.NullInt32) int32 {
+	return from.Int32
+}
+
+func ConvertNullInt32ToInt32Ptr(from sql.NullInt32) *int32 {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Int32
+}
+
+func ConvertInt32ToNullInt32(from int32) sql.NullInt32 {
+	return sql.NullInt32{Int32: from, Valid: true}
+}
+
+func ConvertInt32PtrToNullInt32(from *int32) sql.NullInt32 {
+	if from == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *from, Valid: true}
+}
+
+func ConvertNullInt16ToInt16(from sql.NullInt16) int16 {
+	return from.Int16
+}
+
+func ConvertNullInt16ToInt16Ptr(from sql.NullInt16) *int16 {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Int16
+}
+
+func ConvertInt16ToNullInt16(from int16) sql.NullInt16 {
+	return sql.NullInt16{Int16: from, Valid: true}
+}
+
+func ConvertInt16PtrToNullInt16(from *int16) sql.NullInt16 {
+	if from == nil {
+		return sql.NullInt16{}
+	}
+	return sql.NullInt16{Int16: *from, Valid: true}
+}
+
+func ConvertNullByteToUint8(from sql.NullByte) uint8 {
+	return from.Byte
+}
+
+func ConvertNullByteToUint8Ptr(from sql.NullByte) *uint8 {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Byte
+}
+
+func ConvertUint8ToNullByte(from uint8) sql.NullByte {
+	return sql.NullByte{Byte: from, Valid: true}
+}
+
+func ConvertUint8PtrToNullByte(from *uint8) sql.NullByte {
+	if from == nil {
+		return sql.NullByte{}
+	}
+	return sql.NullByte{Byte: *from, Valid: true}
+}
+
+func ConvertNullFloat64ToFloat64(from sql.NullFloat64) float64 {
+	return from.Float64
+}
+
+func ConvertNullFloat64ToFloat64Ptr(from sql.NullFloat64) *float64 {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Float64
+}
+
+func ConvertFloat64ToNullFloat64(from float64) sql.NullFloat64 {
+	return sql.NullFloat64{Float64: from, Valid: true}
+}
+
+func ConvertFloat64PtrToNullFloat64(from *float64) sql.NullFloat64 {
+	if from == nil {
+		return sql.NullFloat64{}
+	}
+	return sql.NullFloat64{Float64: *from, Valid: true}
+}
+
+func ConvertNullBoolToBool(from sql.NullBool) bool {
+	return from.Bool
+}
+
+func ConvertNullBoolToBoolPtr(from sql.NullBool) *bool {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Bool
+}
+
+func ConvertBoolToNullBool(from bool) sql.NullBool {
+	return sql.NullBool{Bool: from, Valid: true}
+}
+
+func ConvertBoolPtrToNullBool(from *bool) sql.NullBool {
+	if from == nil {
+		return sql.NullBool{}
+	}
+	return sql.NullBool{Bool: *from, Valid: true}
+}
+
+func ConvertNullTimeToTime(from sql.NullTime) time.Time {
+	return from.Time
+}
+
+func ConvertNullTimeToTimePtr(from sql.NullTime) *time.Time {
+	if !from.Valid {
+		return nil
+	}
+	return &from.Time
+}
+
+func ConvertTimeToNullTime(from time.Time) sql.NullTime {
+	return sql.NullTime{Time: from, Valid: true}
+}
+
+func ConvertTimePtrToNullTime(from *time.Time) sql.NullTime {
+	if from == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *from, Valid: true}
+}
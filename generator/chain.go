@@ -0,0 +1,258 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/underbek/datamapper/models"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// chainPath is a chain of conversion functions with count of lossy and error-returning functions
+type chainPath struct {
+	functions []models.ConversionFunction
+	lossy     int
+	errors    int
+}
+
+func (p chainPath) with(cf models.ConversionFunction) chainPath {
+	res := chainPath{
+		functions: make([]models.ConversionFunction, 0, len(p.functions)+1),
+		lossy:     p.lossy,
+		errors:    p.errors,
+	}
+
+	res.functions = append(res.functions, p.functions...)
+	res.functions = append(res.functions, cf)
+
+	if cf.Lossy {
+		res.lossy++
+	}
+
+	if cf.WithError {
+		res.errors++
+	}
+
+	return res
+}
+
+// less compares paths with the same length. Path without lossy and error-returning functions is preferred
+func (p chainPath) less(other chainPath) bool {
+	if p.lossy != other.lossy {
+		return p.lossy < other.lossy
+	}
+
+	if p.errors != other.errors {
+		return p.errors < other.errors
+	}
+
+	return ChainDescription(p.functions) < ChainDescription(other.functions)
+}
+
+// FindConversionChain finds the shortest chain of conversion functions from type to type
+// with at most maxLen functions. Methods of convertor struct dependencies are not used in chains
+func FindConversionChain(from, to models.Type, functions models.Functions, maxLen int) (
+	[]models.ConversionFunction, bool) {
+
+	from.Pointer = false
+	to.Pointer = false
+
+	edges := make(map[models.Type][]models.ConversionFunction)
+	for key, cf := range functions {
+		if cf.Receiver != "" {
+			continue
+		}
+
+		cf.FromType = key.FromType
+		cf.ToType = key.ToType
+		edges[key.FromType] = append(edges[key.FromType], cf)
+	}
+
+	current := map[models.Type]chainPath{from: {}}
+	visited := map[models.Type]struct{}{from: {}}
+
+	for i := 0; i < maxLen && len(current) != 0; i++ {
+		next := make(map[models.Type]chainPath)
+		for node, path := range current {
+			for _, cf := range edges[node] {
+				if _, ok := visited[cf.ToType]; ok {
+					continue
+				}
+
+				candidate := path.with(cf)
+				if prev, ok := next[cf.ToType]; !ok || candidate.less(prev) {
+					next[cf.ToType] = candidate
+				}
+			}
+		}
+
+		if path, ok := next[to]; ok {
+			return path.functions, true
+		}
+
+		for node := range next {
+			visited[node] = struct{}{}
+		}
+
+		current = next
+	}
+
+	return nil, false
+}
+
+// ChainDescription returns chain of conversion functions names like ConvertAToString -> ConvertStringToB
+func ChainDescription(chain []models.ConversionFunction) string {
+	names := make([]string, 0, len(chain))
+	for _, cf := range chain {
+		names = append(names, cf.Name)
+	}
+
+	return strings.Join(names, " -> ")
+}
+
+// GenerateChainConvertor generates convertor which calls chain of conversion functions one by one.
+// Convertor name like ConvertAToB is suffixed by number if it is already declared in package by names
+func GenerateChainConvertor(chain []models.ConversionFunction, pkg models.Package, names map[string]struct{}) (
+	models.GeneratedConversionFunction, error) {
+
+	if len(chain) == 0 {
+		return models.GeneratedConversionFunction{}, fmt.Errorf("%w: empty conversion chain", ErrNotFound)
+	}
+
+	from := chain[0].FromType
+	to := chain[len(chain)-1].ToType
+
+	function := models.ConversionFunction{
+		Name:      chainConvertorName(from, to, pkg.Path, names),
+		Package:   pkg,
+		FromType:  from,
+		ToType:    to,
+		TypeParam: models.NoTypeParam,
+	}
+
+	packages := make(models.Packages)
+	for _, t := range []models.Type{from, to} {
+		if t.Package.Path != "" {
+			packages[t.Package] = struct{}{}
+		}
+	}
+
+	type step struct {
+		Var       string
+		Call      string
+		WithError bool
+		Error     string
+	}
+
+	steps := make([]step, 0, len(chain))
+	arg := "from"
+	for i, cf := range chain {
+		if cf.Package.Path != "" {
+			packages[cf.Package] = struct{}{}
+		}
+
+		function.WithError = function.WithError || cf.WithError
+		function.WithContext = function.WithContext || cf.WithContext
+		function.Lossy = function.Lossy || cf.Lossy
+
+		current := step{
+			Var:       fmt.Sprintf("step%d", i+1),
+			Call:      getConversionFunctionCall(cf, cf.FromType, cf.ToType, pkg.Path, arg),
+			WithError: cf.WithError,
+			Error: fmt.Sprintf(
+				"convert %s -> %s by %s failed",
+				typeFullName(cf.FromType, pkg.Path),
+				typeFullName(cf.ToType, pkg.Path),
+				cf.Name,
+			),
+		}
+
+		steps = append(steps, current)
+		arg = current.Var
+	}
+
+	if function.WithError {
+		packages[models.Package{
+			Name: "fmt",
+			Path: "fmt",
+		}] = struct{}{}
+	}
+
+	if function.WithContext {
+		packages[models.Package{
+			Name: "context",
+			Path: "context",
+		}] = struct{}{}
+	}
+
+	data := map[string]any{
+		"name":        function.Name,
+		"fromName":    typeFullName(from, pkg.Path),
+		"toName":      typeFullName(to, pkg.Path),
+		"chain":       ChainDescription(chain),
+		"steps":       steps,
+		"result":      arg,
+		"withError":   function.WithError,
+		"withContext": function.WithContext,
+	}
+
+	body, err := fillTemplate[string](chainFilePath, data)
+	if err != nil {
+		return models.GeneratedConversionFunction{}, err
+	}
+
+	return models.GeneratedConversionFunction{
+		Function: function,
+		Packages: packages,
+		Body:     body,
+	}, nil
+}
+
+// chainConvertorName returns convertor name like ConvertAToB which is not declared in package by names
+func chainConvertorName(from, to models.Type, pkgPath string, names map[string]struct{}) string {
+	name := fmt.Sprintf("Convert%sTo%s", chainTypeName(from, pkgPath), chainTypeName(to, pkgPath))
+	if _, ok := names[name]; !ok {
+		return name
+	}
+
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s%d", name, i)
+		if _, ok := names[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+// chainTypeName returns type name for chain convertor name like NetipAddr or StringSlice
+func chainTypeName(t models.Type, pkgPath string) string {
+	title := cases.Title(language.Und, cases.NoLower)
+
+	switch additional := t.Additional.(type) {
+	case models.SliceAdditional:
+		return chainTypeName(additional.InType, pkgPath) + "Slice"
+	case models.ArrayAdditional:
+		return chainTypeName(additional.InType, pkgPath) + "Array"
+	case models.MapAdditional:
+		return chainTypeName(additional.KeyType, pkgPath) + chainTypeName(additional.ValueType, pkgPath) + "Map"
+	}
+
+	name := title.String(t.Name)
+	if additional, ok := t.Additional.(models.GenericAdditional); ok {
+		name += "Of" + chainTypeName(additional.TypeArg, pkgPath)
+	}
+	if t.Pointer {
+		name += "Ptr"
+	}
+
+	if t.Package.Path == "" || t.Package.Path == pkgPath {
+		return name
+	}
+
+	pkgName := t.Package.Name
+	if t.Package.Alias != "" {
+		pkgName = t.Package.Alias
+	}
+
+	return title.String(pkgName) + name
+}
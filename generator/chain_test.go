@@ -0,0 +1,158 @@
+package generator
+
+import (
+	"go/format"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_FindConversionChain(t *testing.T) {
+	typeA := models.Type{Name: "A", Package: models.Package{Name: "a", Path: "a"}, Kind: models.StructType}
+	typeB := models.Type{Name: "B", Package: models.Package{Name: "b", Path: "b"}, Kind: models.StructType}
+	typeString := models.Type{Name: "string"}
+	typeInt := models.Type{Name: "int"}
+	typeFloat := models.Type{Name: "float64"}
+
+	function := func(name string, from, to models.Type, withError, lossy bool) models.ConversionFunction {
+		return models.ConversionFunction{
+			Name:      name,
+			FromType:  from,
+			ToType:    to,
+			WithError: withError,
+			Lossy:     lossy,
+		}
+	}
+
+	aToString := function("ConvertAToString", typeA, typeString, false, false)
+	stringToB := function("ConvertStringToB", typeString, typeB, true, false)
+	aToInt := function("ConvertAToInt", typeA, typeInt, false, false)
+	intToB := function("ConvertIntToB", typeInt, typeB, false, false)
+	aToFloat := function("ConvertAToFloat", typeA, typeFloat, false, true)
+	floatToB := function("ConvertFloatToB", typeFloat, typeB, false, false)
+	stringToInt := function("ConvertStringToInt", typeString, typeInt, false, false)
+
+	tests := []struct {
+		name      string
+		functions []models.ConversionFunction
+		maxLen    int
+		expected  []models.ConversionFunction
+	}{
+		{
+			name:      "Simple chain",
+			functions: []models.ConversionFunction{aToString, stringToB},
+			maxLen:    2,
+			expected:  []models.ConversionFunction{aToString, stringToB},
+		},
+		{
+			name:      "Too long chain",
+			functions: []models.ConversionFunction{aToString, stringToInt, intToB},
+			maxLen:    2,
+		},
+		{
+			name:      "Without error",
+			functions: []models.ConversionFunction{aToString, stringToB, aToInt, intToB},
+			maxLen:    2,
+			expected:  []models.ConversionFunction{aToInt, intToB},
+		},
+		{
+			name:      "Without lossy",
+			functions: []models.ConversionFunction{aToString, stringToB, aToFloat, floatToB},
+			maxLen:    2,
+			expected:  []models.ConversionFunction{aToString, stringToB},
+		},
+		{
+			name:      "Shortest chain",
+			functions: []models.ConversionFunction{aToString, stringToInt, intToB, aToFloat, floatToB},
+			maxLen:    3,
+			expected:  []models.ConversionFunction{aToFloat, floatToB},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			functions := make(models.Functions)
+			for _, cf := range tt.functions {
+				functions[models.ConversionFunctionKey{FromType: cf.FromType, ToType: cf.ToType}] = cf
+			}
+
+			chain, ok := FindConversionChain(typeA, typeB, functions, tt.maxLen)
+			assert.Equal(t, tt.expected != nil, ok)
+			assert.Equal(t, tt.expected, chain)
+		})
+	}
+}
+
+func Test_GenerateChainConvertor(t *testing.T) {
+	pkg := models.Package{Name: "mapper", Path: "mapper"}
+	domain := models.Package{Name: "domain", Path: "domain"}
+	dto := models.Package{Name: "dto", Path: "dto"}
+	typeUser := models.Type{Name: "User", Package: domain, Kind: models.StructType}
+	typeAccount := models.Type{Name: "Account", Package: dto, Kind: models.StructType}
+	typeString := models.Type{Name: "string"}
+	typeInt := models.Type{Name: "int"}
+
+	chain := []models.ConversionFunction{
+		{Name: "ConvertUserToString", Package: domain, FromType: typeUser, ToType: typeString},
+		{Name: "ConvertStringToInt", Package: domain, FromType: typeString, ToType: typeInt, WithError: true},
+		{Name: "ConvertIntToAccount", Package: dto, FromType: typeInt, ToType: typeAccount},
+	}
+
+	expectedBody := func(name string) string {
+		return "// " + name + ` convert domain.User to dto.Account by chain ` +
+			`ConvertUserToString -> ConvertStringToInt -> ConvertIntToAccount
+func ` + name + `(from domain.User) (res dto.Account, err error) {
+	step1 := domain.ConvertUserToString(from)
+
+	step2, err := domain.ConvertStringToInt(step1)
+	if err != nil {
+		return res, fmt.Errorf("convert string -> int by ConvertStringToInt failed: %w", err)
+	}
+
+	step3 := dto.ConvertIntToAccount(step2)
+
+	return step3, nil
+}
+`
+	}
+
+	tests := []struct {
+		name     string
+		names    map[string]struct{}
+		expected string
+	}{
+		{
+			name:     "Middle step with error",
+			expected: "ConvertDomainUserToDtoAccount",
+		},
+		{
+			name:     "Declared name",
+			names:    map[string]struct{}{"ConvertDomainUserToDtoAccount": {}},
+			expected: "ConvertDomainUserToDtoAccount2",
+		},
+		{
+			name: "Declared names",
+			names: map[string]struct{}{
+				"ConvertDomainUserToDtoAccount":  {},
+				"ConvertDomainUserToDtoAccount2": {},
+			},
+			expected: "ConvertDomainUserToDtoAccount3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gcf, err := GenerateChainConvertor(chain, pkg, tt.names)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, gcf.Function.Name)
+			assert.True(t, gcf.Function.WithError)
+
+			body, err := format.Source([]byte(gcf.Body))
+			require.NoError(t, err)
+			assert.Equal(t, expectedBody(tt.expected), string(body))
+		})
+	}
+}
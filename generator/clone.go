@@ -0,0 +1,357 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+
+	"github.com/underbek/datamapper/models"
+)
+
+// cloneGenerator generates deep copy functions of model and its nested models
+type cloneGenerator struct {
+	pkgPath      string
+	modelPkgPath string
+	structs      map[models.Type]models.Struct
+	collections  map[models.Type]models.Type
+	withVisited  bool
+	withRoot     bool
+	packages     models.Packages
+	queue        []models.Struct
+	generated    map[models.Type]struct{}
+	counter      int
+}
+
+// GenerateClone generates deep copy function of model. Pointers, slices, maps and arrays are copied.
+// Named collections like type Tags []string are copied by their underlying types from collections.
+// Nested models from structs are copied by generated functions too. Structs of other packages with unexported
+// fields and structs not found in structs like time.Time are copied by value.
+// Pointers to nested models are copied once, so cyclic and shared pointers are kept in copy.
+// Pointer model is copied by pointer, so pointers to it from nested models are replaced by copy too.
+// Structs and collections are keyed by types without pointers and package aliases.
+func GenerateClone(model models.Struct, structs map[models.Type]models.Struct,
+	collections map[models.Type]models.Type, pkg models.Package) (string, models.Packages, error) {
+
+	g := &cloneGenerator{
+		pkgPath:      pkg.Path,
+		modelPkgPath: model.Type.Package.Path,
+		structs:      structs,
+		collections:  collections,
+		packages:     make(models.Packages),
+		generated:    make(map[models.Type]struct{}),
+	}
+
+	pointer := model.Type.Pointer
+	model.Type.Pointer = false
+	g.withVisited = g.hasModelPointer(model, make(map[models.Type]struct{}))
+	g.withRoot = g.withVisited || pointer
+
+	var bodies []string
+	if g.withRoot {
+		body, err := fillTemplate[string](cloneRootFilePath, map[string]any{
+			"name":        g.publicName(model),
+			"cloneName":   g.privateName(model),
+			"typeName":    model.Type.FullName(pkg.Path),
+			"pointer":     pointer,
+			"withVisited": g.withVisited,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+
+		bodies = append(bodies, body)
+	}
+
+	g.enqueue(model)
+	for len(g.queue) != 0 {
+		current := g.queue[0]
+		g.queue = g.queue[1:]
+
+		body, err := g.generateClone(current)
+		if err != nil {
+			return "", nil, err
+		}
+
+		bodies = append(bodies, body)
+	}
+
+	return strings.Join(bodies, "\n"), g.packages, nil
+}
+
+func (g *cloneGenerator) publicName(model models.Struct) string {
+	return "Clone" + structNameGenerator(model, g.pkgPath)
+}
+
+func (g *cloneGenerator) privateName(model models.Struct) string {
+	if !g.withRoot {
+		return g.publicName(model)
+	}
+
+	return "clone" + structNameGenerator(model, g.pkgPath)
+}
+
+func (g *cloneGenerator) enqueue(model models.Struct) {
+	key := cloneKey(model.Type)
+	if _, ok := g.generated[key]; ok {
+		return
+	}
+
+	g.generated[key] = struct{}{}
+	g.queue = append(g.queue, model)
+}
+
+// nestedModel returns model by type. Structs of other packages are nested models only if all their fields
+// are exported, because unexported fields can't be copied outside of struct package
+func (g *cloneGenerator) nestedModel(t models.Type) (models.Struct, bool) {
+	if t.Kind != models.StructType {
+		return models.Struct{}, false
+	}
+
+	model, ok := g.structs[cloneKey(t)]
+	if !ok {
+		return models.Struct{}, false
+	}
+
+	if model.Type.Package.Path != g.modelPkgPath && model.Type.Package.Path != g.pkgPath &&
+		!isExportedStruct(model) {
+		return models.Struct{}, false
+	}
+
+	model.Type.Package.Alias = t.Package.Alias
+	model.Type.Pointer = false
+
+	return model, true
+}
+
+// underlyingType returns type with underlying collection type of named collection
+func (g *cloneGenerator) underlyingType(t models.Type) models.Type {
+	if t.Kind != models.RedefinedType {
+		return t
+	}
+
+	underlying, ok := g.collections[cloneKey(t)]
+	if !ok {
+		return t
+	}
+
+	t.Additional = underlying.Additional
+	return t
+}
+
+// hasModelPointer checks that model or its nested models have pointers to nested models
+func (g *cloneGenerator) hasModelPointer(model models.Struct, visited map[models.Type]struct{}) bool {
+	key := cloneKey(model.Type)
+	if _, ok := visited[key]; ok {
+		return false
+	}
+	visited[key] = struct{}{}
+
+	for _, field := range model.Fields {
+		if g.hasTypeModelPointer(field.Type, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (g *cloneGenerator) hasTypeModelPointer(t models.Type, visited map[models.Type]struct{}) bool {
+	if nested, ok := g.nestedModel(t); ok {
+		return t.Pointer || g.hasModelPointer(nested, visited)
+	}
+
+	switch additional := g.underlyingType(t).Additional.(type) {
+	case models.SliceAdditional:
+		return g.hasTypeModelPointer(additional.InType, visited)
+	case models.ArrayAdditional:
+		return g.hasTypeModelPointer(additional.InType, visited)
+	case models.MapAdditional:
+		return g.hasTypeModelPointer(additional.ValueType, visited)
+	}
+
+	return false
+}
+
+func (g *cloneGenerator) generateClone(model models.Struct) (string, error) {
+	var fields []string
+	for _, field := range model.Fields {
+		stmts := g.copyStmts(fmt.Sprintf("res.%s", field.Name), fmt.Sprintf("from.%s", field.Name), field.Type)
+		if len(stmts) == 0 {
+			continue
+		}
+
+		if !token.IsExported(field.Name) && g.modelPkgPath != g.pkgPath {
+			return "", fmt.Errorf(
+				"%w: unexported field %s.%s can't be copied in other package",
+				ErrUndefinedConversionRule,
+				model.Type.Name,
+				field.Name,
+			)
+		}
+
+		fields = append(fields, strings.Join(stmts, "\n"))
+	}
+
+	g.packages[model.Type.Package] = struct{}{}
+
+	return fillTemplate[string](cloneFilePath, map[string]any{
+		"name":        g.privateName(model),
+		"typeName":    model.Type.FullName(g.pkgPath),
+		"withVisited": g.withVisited,
+		"fields":      fields,
+	})
+}
+
+func (g *cloneGenerator) nextName(prefix string) string {
+	g.counter++
+	return fmt.Sprintf("%s%d", prefix, g.counter)
+}
+
+// copyStmts returns statements which replace shared parts of dst by copies of src parts.
+// dst already contains shallow copy of src.
+func (g *cloneGenerator) copyStmts(dst, src string, t models.Type) []string {
+	if t.Pointer {
+		return g.pointerCopyStmts(dst, src, t)
+	}
+
+	if nested, ok := g.nestedModel(t); ok {
+		g.enqueue(nested)
+		return []string{fmt.Sprintf("%s = %s(%s%s)", dst, g.privateName(nested), src, g.visitedArg())}
+	}
+
+	// named collection is made by its name and copied by its underlying type
+	switch additional := g.underlyingType(t).Additional.(type) {
+	case models.SliceAdditional:
+		index := g.nextName("i")
+		itemStmts := g.copyStmts(fmt.Sprintf("%s[%s]", dst, index), fmt.Sprintf("%s[%s]", src, index),
+			additional.InType)
+
+		g.addTypePackages(t)
+		stmts := []string{
+			fmt.Sprintf("if %s != nil {", src),
+			fmt.Sprintf("%s = make(%s, len(%s))", dst, typeFullName(t, g.pkgPath), src),
+			fmt.Sprintf("copy(%s, %s)", dst, src),
+		}
+
+		if len(itemStmts) != 0 {
+			stmts = append(stmts, fmt.Sprintf("for %s := range %s {", index, src))
+			stmts = append(stmts, itemStmts...)
+			stmts = append(stmts, "}")
+		}
+
+		return append(stmts, "}")
+
+	case models.ArrayAdditional:
+		index := g.nextName("i")
+		itemStmts := g.copyStmts(fmt.Sprintf("%s[%s]", dst, index), fmt.Sprintf("%s[%s]", src, index),
+			additional.InType)
+		if len(itemStmts) == 0 {
+			return nil
+		}
+
+		stmts := []string{fmt.Sprintf("for %s := range %s {", index, src)}
+		stmts = append(stmts, itemStmts...)
+		return append(stmts, "}")
+
+	case models.MapAdditional:
+		key := g.nextName("k")
+		value := g.nextName("v")
+
+		g.addTypePackages(t)
+		stmts := []string{
+			fmt.Sprintf("if %s != nil {", src),
+			fmt.Sprintf("%s = make(%s, len(%s))", dst, typeFullName(t, g.pkgPath), src),
+			fmt.Sprintf("for %s, %s := range %s {", key, value, src),
+		}
+
+		// map items are not addressable, so item is copied by variable
+		if nested, ok := g.nestedModel(additional.ValueType); ok && !additional.ValueType.Pointer {
+			g.enqueue(nested)
+			stmts = append(stmts, fmt.Sprintf("%s[%s] = %s(%s%s)", dst, key, g.privateName(nested), value,
+				g.visitedArg()))
+			return append(stmts, "}", "}")
+		}
+
+		item := g.nextName("item")
+		stmts = append(stmts, fmt.Sprintf("%s := %s", item, value))
+		stmts = append(stmts, g.copyStmts(item, value, additional.ValueType)...)
+		stmts = append(stmts, fmt.Sprintf("%s[%s] = %s", dst, key, item), "}", "}")
+
+		return stmts
+	}
+
+	return nil
+}
+
+func (g *cloneGenerator) pointerCopyStmts(dst, src string, t models.Type) []string {
+	valueType := t
+	valueType.Pointer = false
+
+	if nested, ok := g.nestedModel(valueType); ok {
+		g.enqueue(nested)
+
+		cached := g.nextName("cached")
+		value := g.nextName("v")
+		return []string{
+			fmt.Sprintf("if %s != nil {", src),
+			fmt.Sprintf("if %s, ok := visited[%s]; ok {", cached, src),
+			fmt.Sprintf("%s = %s.(%s)", dst, cached, typeFullName(t, g.pkgPath)),
+			"} else {",
+			fmt.Sprintf("%s := new(%s)", value, typeFullName(valueType, g.pkgPath)),
+			fmt.Sprintf("visited[%s] = %s", src, value),
+			fmt.Sprintf("*%s = %s(*%s, visited)", value, g.privateName(nested), src),
+			fmt.Sprintf("%s = %s", dst, value),
+			"}",
+			"}",
+		}
+	}
+
+	value := g.nextName("v")
+	stmts := []string{
+		fmt.Sprintf("if %s != nil {", src),
+		fmt.Sprintf("%s := *%s", value, src),
+	}
+	stmts = append(stmts, g.copyStmts(value, fmt.Sprintf("(*%s)", src), valueType)...)
+
+	return append(stmts, fmt.Sprintf("%s = &%s", dst, value), "}")
+}
+
+func (g *cloneGenerator) visitedArg() string {
+	if g.withVisited {
+		return ", visited"
+	}
+
+	return ""
+}
+
+func (g *cloneGenerator) addTypePackages(t models.Type) {
+	g.packages[t.Package] = struct{}{}
+
+	switch additional := t.Additional.(type) {
+	case models.SliceAdditional:
+		g.addTypePackages(additional.InType)
+	case models.ArrayAdditional:
+		g.addTypePackages(additional.InType)
+	case models.MapAdditional:
+		g.addTypePackages(additional.KeyType)
+		g.addTypePackages(additional.ValueType)
+	}
+}
+
+// cloneKey returns key of structs and collections by type
+func cloneKey(t models.Type) models.Type {
+	t.Pointer = false
+	t.Package.Alias = ""
+
+	return t
+}
+
+func isExportedStruct(model models.Struct) bool {
+	for _, field := range model.Fields {
+		if !token.IsExported(field.Name) {
+			return false
+		}
+	}
+
+	return true
+}
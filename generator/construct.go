@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+
+	"github.com/underbek/datamapper/models"
+)
+
+// constructionSetter is a statement which sets target field after construction
+type constructionSetter struct {
+	Call  string
+	Error string
+}
+
+// fillConstruction replaces fields assignments by call of target model constructor and its setters.
+// Fields which are not passed to constructor are set by setters or assigned if they are accessible.
+// Target model without constructor and setters is created by fields assignments
+func fillConstruction(res *result, to models.Struct, pkgPath string, opts ConvertorOptions) error {
+	pairs := make(map[string]FieldsPair, len(res.fields))
+	for _, pair := range res.fields {
+		pairs[pair.ToName] = pair
+	}
+
+	constructor, args, err := findConstructor(to, pairs, opts)
+	if err != nil {
+		return err
+	}
+
+	if constructor == nil && len(to.Setters) == 0 {
+		return nil
+	}
+
+	toName := to.Type.FullName(pkgPath)
+	data := map[string]any{
+		"resValue": nilOrDefault(toName),
+		"resName":  strings.Replace(toName, "*", "&", 1),
+	}
+
+	withError := false
+	constructed := make(map[string]struct{})
+	if constructor != nil {
+		data["resVar"] = "res"
+		if constructor.Pointer != to.Type.Pointer {
+			data["resVar"] = "constructed"
+			data["dereference"] = "&constructed"
+			if constructor.Pointer {
+				data["dereference"] = "*constructed"
+			}
+		}
+
+		name := models.Type{Name: constructor.Name, Package: to.Type.Package}.FullName(pkgPath)
+		data["constructor"] = fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+
+		if constructor.WithError {
+			data["constructorError"], err = getConstructError(to.Type.Name, constructor.Name)
+			if err != nil {
+				return err
+			}
+
+			withError = true
+		}
+
+		for _, param := range constructor.Params {
+			field, _ := constructorParamField(param, to, opts.ConstructorParams)
+			constructed[field.Name] = struct{}{}
+		}
+	}
+
+	setters := make([]constructionSetter, 0, len(res.fields))
+	for _, pair := range res.fields {
+		if _, ok := constructed[pair.ToName]; ok {
+			continue
+		}
+
+		if setter, ok := to.Setters[pair.ToName]; ok {
+			call := constructionSetter{Call: fmt.Sprintf("res.%s(%s)", setter.Name, pair.Assignment)}
+			if setter.WithError {
+				call.Error, err = getConstructError(to.Type.Name, setter.Name)
+				if err != nil {
+					return err
+				}
+
+				withError = true
+			}
+
+			setters = append(setters, call)
+			continue
+		}
+
+		if !token.IsExported(pair.ToName) && to.Type.Package.Path != pkgPath {
+			return fmt.Errorf(
+				"%w: unexported field %s.%s has not setter",
+				ErrConstruction,
+				to.Type.Name,
+				pair.ToName,
+			)
+		}
+
+		setters = append(setters, constructionSetter{Call: fmt.Sprintf("res.%s = %s", pair.ToName, pair.Assignment)})
+	}
+
+	data["setters"] = setters
+
+	res.construction, err = fillTemplate[string](constructFilePath, data)
+	if err != nil {
+		return err
+	}
+
+	if withError {
+		res.withError = true
+		res.packages[models.Package{
+			Name: "fmt",
+			Path: "fmt",
+		}] = struct{}{}
+	}
+
+	return nil
+}
+
+// findConstructor finds constructor of target model and its arguments by fields pairs.
+// Explicit constructor must be matched, otherwise constructor like NewUser is preferred
+// and other constructors are tried by name order
+func findConstructor(to models.Struct, pairs map[string]FieldsPair, opts ConvertorOptions) (
+	*models.Constructor, []string, error) {
+
+	if opts.Constructor != "" {
+		for i := range to.Constructors {
+			if to.Constructors[i].Name != opts.Constructor {
+				continue
+			}
+
+			args, err := constructorArgs(to.Constructors[i], to, pairs, opts.ConstructorParams)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			return &to.Constructors[i], args, nil
+		}
+
+		return nil, nil, fmt.Errorf(
+			"%w: constructor %s of model %s",
+			ErrNotFound,
+			opts.Constructor,
+			to.Type.Name,
+		)
+	}
+
+	constructors := make([]models.Constructor, len(to.Constructors))
+	copy(constructors, to.Constructors)
+
+	defaultName := "New" + to.Type.Name
+	sort.SliceStable(constructors, func(i, j int) bool {
+		return constructors[i].Name == defaultName && constructors[j].Name != defaultName
+	})
+
+	for i := range constructors {
+		args, err := constructorArgs(constructors[i], to, pairs, opts.ConstructorParams)
+		if err != nil {
+			continue
+		}
+
+		return &constructors[i], args, nil
+	}
+
+	return nil, nil, nil
+}
+
+// constructorArgs returns assignments of fields passed to constructor params
+func constructorArgs(constructor models.Constructor, to models.Struct, pairs map[string]FieldsPair,
+	params map[string]string) ([]string, error) {
+
+	args := make([]string, 0, len(constructor.Params))
+	for _, param := range constructor.Params {
+		field, ok := constructorParamField(param, to, params)
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: param %s of %s is not matched with fields of %s",
+				ErrConstruction,
+				param.Name,
+				constructor.Name,
+				to.Type.Name,
+			)
+		}
+
+		if !isSameTypesWithoutAlias(param.Type, field.Type) {
+			return nil, fmt.Errorf(
+				"%w: param %s of %s has type %s, but field %s.%s has type %s",
+				ErrConstruction,
+				param.Name,
+				constructor.Name,
+				param.Type.Name,
+				to.Type.Name,
+				field.Name,
+				field.Type.Name,
+			)
+		}
+
+		pair, ok := pairs[field.Name]
+		if !ok {
+			return nil, fmt.Errorf(
+				"%w: param %s of %s has not source field with tag %s",
+				ErrConstruction,
+				param.Name,
+				constructor.Name,
+				field.Tags[0].Value,
+			)
+		}
+
+		args = append(args, pair.Assignment)
+	}
+
+	return args, nil
+}
+
+// constructorParamField finds target field of constructor param by explicit tag value of param,
+// then by field name and then by tag value equal to param name
+func constructorParamField(param models.Param, to models.Struct, params map[string]string) (models.Field, bool) {
+	if tag, ok := params[param.Name]; ok {
+		for _, field := range to.Fields {
+			if field.Tags[0].Value == tag {
+				return field, true
+			}
+		}
+
+		return models.Field{}, false
+	}
+
+	for _, field := range to.Fields {
+		if strings.EqualFold(field.Name, param.Name) {
+			return field, true
+		}
+	}
+
+	for _, field := range to.Fields {
+		if field.Tags[0].Value == param.Name {
+			return field, true
+		}
+	}
+
+	return models.Field{}, false
+}
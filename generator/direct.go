@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/underbek/datamapper/models"
+)
+
+// IsDirectConvertible checks that models have identical underlying types ignoring tags
+// and tags pair all fields of both models with the same names,
+// so one model can be converted to another by type conversion like dto.User(from)
+func IsDirectConvertible(from, to models.Struct) bool {
+	if from.Underlying == nil || to.Underlying == nil || !isIdenticalType(from.Underlying, to.Underlying) {
+		return false
+	}
+
+	// fields without tags are not converted, so type conversion is used only if all fields are tagged
+	if len(from.Fields) != from.Underlying.NumFields() || len(to.Fields) != to.Underlying.NumFields() {
+		return false
+	}
+
+	toNames := make(map[string]string, len(to.Fields))
+	for _, field := range to.Fields {
+		if len(field.Tags) == 0 {
+			return false
+		}
+
+		toNames[field.Tags[0].Value] = field.Name
+	}
+
+	for _, field := range from.Fields {
+		if len(field.Tags) == 0 {
+			return false
+		}
+
+		name, ok := toNames[field.Tags[0].Value]
+		if !ok || name != field.Name {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isIdenticalType checks types identity ignoring struct tags like types.IdenticalIgnoreTags.
+// Models can be parsed by different packages loads, so named types like time.Time are not the same objects
+// and are compared by package paths and names
+func isIdenticalType(x, y types.Type) bool {
+	x, y = types.Unalias(x), types.Unalias(y)
+
+	switch x := x.(type) {
+	case *types.Basic:
+		y, ok := y.(*types.Basic)
+		return ok && x.Kind() == y.Kind()
+	case *types.Named:
+		y, ok := y.(*types.Named)
+		if !ok || x.Obj().Name() != y.Obj().Name() || packagePath(x.Obj()) != packagePath(y.Obj()) {
+			return false
+		}
+
+		if x.TypeArgs().Len() != y.TypeArgs().Len() {
+			return false
+		}
+
+		for i := 0; i < x.TypeArgs().Len(); i++ {
+			if !isIdenticalType(x.TypeArgs().At(i), y.TypeArgs().At(i)) {
+				return false
+			}
+		}
+
+		return true
+	case *types.Pointer:
+		y, ok := y.(*types.Pointer)
+		return ok && isIdenticalType(x.Elem(), y.Elem())
+	case *types.Slice:
+		y, ok := y.(*types.Slice)
+		return ok && isIdenticalType(x.Elem(), y.Elem())
+	case *types.Array:
+		y, ok := y.(*types.Array)
+		return ok && x.Len() == y.Len() && isIdenticalType(x.Elem(), y.Elem())
+	case *types.Map:
+		y, ok := y.(*types.Map)
+		return ok && isIdenticalType(x.Key(), y.Key()) && isIdenticalType(x.Elem(), y.Elem())
+	case *types.Chan:
+		y, ok := y.(*types.Chan)
+		return ok && x.Dir() == y.Dir() && isIdenticalType(x.Elem(), y.Elem())
+	case *types.Struct:
+		y, ok := y.(*types.Struct)
+		if !ok || x.NumFields() != y.NumFields() {
+			return false
+		}
+
+		for i := 0; i < x.NumFields(); i++ {
+			if !isIdenticalVar(x.Field(i), y.Field(i)) || x.Field(i).Embedded() != y.Field(i).Embedded() {
+				return false
+			}
+		}
+
+		return true
+	case *types.Signature:
+		y, ok := y.(*types.Signature)
+		return ok && x.Variadic() == y.Variadic() &&
+			isIdenticalTuple(x.Params(), y.Params()) && isIdenticalTuple(x.Results(), y.Results())
+	case *types.Interface:
+		y, ok := y.(*types.Interface)
+		if !ok || x.NumMethods() != y.NumMethods() || x.IsComparable() != y.IsComparable() {
+			return false
+		}
+
+		// methods of interface are sorted by names
+		for i := 0; i < x.NumMethods(); i++ {
+			if !isIdenticalVar(x.Method(i), y.Method(i)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return types.Identical(x, y)
+}
+
+// isIdenticalVar checks names and types of fields or methods. Unexported names must be from the same package
+func isIdenticalVar(x, y types.Object) bool {
+	if x.Name() != y.Name() || (!x.Exported() && packagePath(x) != packagePath(y)) {
+		return false
+	}
+
+	return isIdenticalType(x.Type(), y.Type())
+}
+
+func isIdenticalTuple(x, y *types.Tuple) bool {
+	if x.Len() != y.Len() {
+		return false
+	}
+
+	for i := 0; i < x.Len(); i++ {
+		if !isIdenticalType(x.At(i).Type(), y.At(i).Type()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// packagePath returns package path of object, universe objects like error have no package
+func packagePath(obj types.Object) string {
+	if obj.Pkg() == nil {
+		return ""
+	}
+
+	return obj.Pkg().Path()
+}
+
+// DirectConversionFunction returns type conversion of models with the same layout as conversion function
+func DirectConversionFunction(from, to models.Type) models.ConversionFunction {
+	from.Pointer = false
+	to.Pointer = false
+
+	return models.ConversionFunction{
+		Name:      to.Name,
+		Package:   to.Package,
+		FromType:  from,
+		ToType:    to,
+		TypeParam: models.NoTypeParam,
+	}
+}
+
+// createDirectPair creates convertor result with type conversion instead of fields pairs
+func createDirectPair(from, to models.Struct, pkgPath string) (result, error) {
+	res, err := getSourcesPointerChecks([]source{{name: "from", model: from}}, to, pkgPath)
+	if err != nil {
+		return result{}, err
+	}
+
+	arg := "from"
+	if from.Type.Pointer {
+		arg = "*from"
+	}
+
+	toType := to.Type
+	toType.Pointer = false
+	res.direct = fmt.Sprintf("%s(%s)", toType.FullName(pkgPath), arg)
+
+	if to.Type.Pointer {
+		res.conversions = append(res.conversions, fmt.Sprintf("converted := %s", res.direct))
+		res.direct = "&converted"
+	}
+
+	return res, nil
+}
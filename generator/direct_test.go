@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_IsDirectConvertible(t *testing.T) {
+	newStruct := func(fields map[string]types.Type, names ...string) *types.Struct {
+		vars := make([]*types.Var, 0, len(names))
+		for _, name := range names {
+			vars = append(vars, types.NewField(token.NoPos, nil, name, fields[name], false))
+		}
+
+		return types.NewStruct(vars, nil)
+	}
+
+	fieldTypes := map[string]types.Type{
+		"ID":   types.Typ[types.Int64],
+		"Name": types.Typ[types.String],
+	}
+
+	// every call creates time.Time like it is loaded by other packages.Load call
+	newTime := func() types.Type {
+		pkg := types.NewPackage("time", "time")
+		return types.NewNamed(types.NewTypeName(token.NoPos, pkg, "Time", nil), types.NewStruct(nil, nil), nil)
+	}
+
+	field := func(name, tag string) models.Field {
+		return models.Field{Name: name, Tags: []models.Tag{{Name: "map", Value: tag}}}
+	}
+
+	underlying := newStruct(fieldTypes, "ID", "Name")
+
+	tests := []struct {
+		name     string
+		from     models.Struct
+		to       models.Struct
+		expected bool
+	}{
+		{
+			name:     "Paired fields",
+			from:     models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+			to:       models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+			expected: true,
+		},
+		{
+			name: "Fields paired with other names",
+			from: models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+			to:   models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "name"), field("Name", "id")}},
+		},
+		{
+			name: "Not tagged field",
+			from: models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id")}},
+			to:   models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id")}},
+		},
+		{
+			name: "Not paired field",
+			from: models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+			to:   models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "title")}},
+		},
+		{
+			name: "Different types",
+			from: models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+			to: models.Struct{
+				Underlying: newStruct(map[string]types.Type{
+					"ID":   types.Typ[types.String],
+					"Name": types.Typ[types.String],
+				}, "ID", "Name"),
+				Fields: []models.Field{field("ID", "id"), field("Name", "name")},
+			},
+		},
+		{
+			name: "Named types of different packages loads",
+			from: models.Struct{
+				Underlying: newStruct(map[string]types.Type{"Created": newTime()}, "Created"),
+				Fields:     []models.Field{field("Created", "created")},
+			},
+			to: models.Struct{
+				Underlying: newStruct(map[string]types.Type{"Created": newTime()}, "Created"),
+				Fields:     []models.Field{field("Created", "created")},
+			},
+			expected: true,
+		},
+		{
+			name: "Unknown underlying type",
+			from: models.Struct{Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+			to:   models.Struct{Underlying: underlying, Fields: []models.Field{field("ID", "id"), field("Name", "name")}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsDirectConvertible(tt.from, tt.to))
+		})
+	}
+}
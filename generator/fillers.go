@@ -20,6 +20,18 @@ const (
 	pointerToPointerConversionFilePath = "templates/pointer_to_pointer_conversion.temp"
 	sliceConversionFilePath            = "templates/slice_conversion.temp"
 	convertErrorFilePath               = "templates/convert_error.temp"
+	convertorStructFilePath            = "templates/convertor_struct.temp"
+	mapperFilePath                     = "templates/mapper.temp"
+	hookCallFilePath                   = "templates/hook_call.temp"
+	computeErrorFilePath               = "templates/compute_error.temp"
+	mapValueSwitchFilePath             = "templates/map_value_switch.temp"
+	mapValueConversionFilePath         = "templates/map_value_conversion.temp"
+	mapItemsConversionFilePath         = "templates/map_items_conversion.temp"
+	cloneFilePath                      = "templates/clone.temp"
+	cloneRootFilePath                  = "templates/clone_root.temp"
+	chainFilePath                      = "templates/chain.temp"
+	constructFilePath                  = "templates/construct.temp"
+	constructErrorFilePath             = "templates/construct_error.temp"
 )
 
 //go:embed templates
@@ -68,21 +80,124 @@ func fillConvertorsSource(pkg models.Package, packages models.Packages, converto
 }
 
 func fillConvertor(res result) (string, error) {
+	params := res.params
+	if params == "" {
+		params = fmt.Sprintf("from %s", res.fromName)
+	}
+
+	fromDescription := res.fromDescription
+	if fromDescription == "" {
+		fromDescription = fmt.Sprintf("%s by tag %s", res.fromName, res.fromTag)
+	}
+
+	// nil result is returned without error if convertor has error result
+	nilError := ""
+	if res.withError {
+		nilError = "nil"
+	}
+
+	conversions := make([]string, 0, len(res.nilChecks)+len(res.conversions))
+	for _, name := range res.nilChecks {
+		conversion, err := getPointerCheck(name, res.toName, nilError)
+		if err != nil {
+			return "", err
+		}
+
+		conversions = append(conversions, conversion)
+	}
+	conversions = append(conversions, res.conversions...)
+
 	data := map[string]any{
-		"fromName":      res.fromName,
-		"toName":        res.toName,
-		"fromTag":       res.fromTag,
-		"toTag":         res.toTag,
-		"convertorName": res.convertorName,
-		"fields":        res.fields,
-		"withError":     res.withError,
-		"conversions":   res.conversions,
-		"resName":       strings.Replace(res.toName, "*", "&", 1),
+		"params":          params,
+		"fromDescription": fromDescription,
+		"fromName":        res.fromName,
+		"toName":          res.toName,
+		"fromTag":         res.fromTag,
+		"toTag":           res.toTag,
+		"convertorName":   res.convertorName,
+		"fields":          res.fields,
+		"withError":       res.withError,
+		"withContext":     res.withContext,
+		"receiver":        res.receiver,
+		"receiverName":    convertorReceiverName,
+		"conversions":     conversions,
+		"beforeHook":      res.beforeHook,
+		"afterHook":       res.afterHook,
+		"validate":        res.validate,
+		"resName":         strings.Replace(res.toName, "*", "&", 1),
+		"direct":          res.direct,
+		"construction":    res.construction,
 	}
 
 	return fillTemplate[string](convertorFilePath, data)
 }
 
+func fillConvertorStruct(name, pkgPath string, dependencies []models.Type) (string, error) {
+	type dependency struct {
+		FieldName string
+		TypeName  string
+	}
+
+	names := dependencyFieldNames(dependencies)
+	added := make(map[string]struct{}, len(dependencies))
+	deps := make([]dependency, 0, len(dependencies))
+	for _, dep := range dependencies {
+		if _, ok := added[dependencyKey(dep)]; ok {
+			continue
+		}
+		added[dependencyKey(dep)] = struct{}{}
+
+		deps = append(deps, dependency{
+			FieldName: names[dependencyKey(dep)],
+			TypeName:  dep.FullName(pkgPath),
+		})
+	}
+
+	data := map[string]any{
+		"name":         name,
+		"dependencies": deps,
+	}
+
+	return fillTemplate[string](convertorStructFilePath, data)
+}
+
+func fillMapper(name, convertor, pkgPath string, functions []models.ConversionFunction) (string, error) {
+	type method struct {
+		Name      string
+		Signature string
+		Args      string
+	}
+
+	methods := make([]method, 0, len(functions))
+	for _, function := range functions {
+		params := fmt.Sprintf("from %s", function.FromType.FullName(pkgPath))
+		args := "from"
+		if function.WithContext {
+			params = "ctx context.Context, " + params
+			args = "ctx, " + args
+		}
+
+		results := function.ToType.FullName(pkgPath)
+		if function.WithError {
+			results = fmt.Sprintf("(%s, error)", results)
+		}
+
+		methods = append(methods, method{
+			Name:      function.Name,
+			Signature: fmt.Sprintf("%s(%s) %s", function.Name, params, results),
+			Args:      args,
+		})
+	}
+
+	data := map[string]any{
+		"name":      name,
+		"convertor": convertor,
+		"functions": methods,
+	}
+
+	return fillTemplate[string](mapperFilePath, data)
+}
+
 func getPointerCheck(fromFullName, toModelName, err string) (string, error) {
 	data := map[string]any{
 		"fromFullName": fromFullName,
@@ -94,13 +209,35 @@ func getPointerCheck(fromFullName, toModelName, err string) (string, error) {
 }
 
 func nilOrDefault(fullName string) string {
-	if strings.HasPrefix(fullName, "*") {
+	if strings.HasPrefix(fullName, "*") || strings.HasPrefix(fullName, "map[") || strings.HasPrefix(fullName, "[]") {
 		return "nil"
 	}
 
 	return fmt.Sprintf("%s{}", fullName)
 }
 
+func getHookCall(hook models.Hook, args, toModelName, pkgPath string) (string, error) {
+	name := models.Type{Name: hook.Name, Package: hook.Package}.FullName(pkgPath)
+
+	data := map[string]any{
+		"call":      fmt.Sprintf("%s(%s)", name, args),
+		"resValue":  nilOrDefault(toModelName),
+		"withError": hook.WithError,
+	}
+
+	return fillTemplate[string](hookCallFilePath, data)
+}
+
+func getValidateCall(toModelName string) (string, error) {
+	data := map[string]any{
+		"call":      "res.Validate()",
+		"resValue":  nilOrDefault(toModelName),
+		"withError": true,
+	}
+
+	return fillTemplate[string](hookCallFilePath, data)
+}
+
 func getErrorConversion(fromFieldFullName, toModelName, conversionFunction, err string) (string, error) {
 	data := map[string]any{
 		"resValue":           nilOrDefault(toModelName),
@@ -137,9 +274,13 @@ func getPointerToPointerConversion(fromFieldResName, fromFieldFullName, toModelN
 	return fillTemplate[string](pointerToPointerConversionFilePath, data)
 }
 
-func getSliceConversion(fromFieldName, toItemTypeName, assigment string, conversions []string) (string, error) {
+func getSliceConversion(source, fromFieldName, fromField, toItemTypeName, assigment string, conversions []string) (
+	string, error) {
+
 	data := map[string]any{
+		"source":         source,
 		"fromFieldName":  fromFieldName,
+		"fromField":      fromField,
 		"toItemTypeName": toItemTypeName,
 		"assigment":      assigment,
 		"conversions":    conversions,
@@ -158,3 +299,70 @@ func getConvertError(fromTypeName, fromFieldName, toTypeName, toFieldName string
 
 	return fillTemplate[string](convertErrorFilePath, data)
 }
+
+func getComputeError(toTypeName, toFieldName, functionName string) (string, error) {
+	data := map[string]any{
+		"toTypeName":   toTypeName,
+		"toFieldName":  toFieldName,
+		"functionName": functionName,
+	}
+
+	return fillTemplate[string](computeErrorFilePath, data)
+}
+
+func getConstructError(toTypeName, functionName string) (string, error) {
+	data := map[string]any{
+		"toTypeName":   toTypeName,
+		"functionName": functionName,
+	}
+
+	return fillTemplate[string](constructErrorFilePath, data)
+}
+
+func getMapValueSwitch(varName, typeName, key, toModelName, toTypeName, toFieldName string,
+	cases []mapValueCase) (string, error) {
+
+	data := map[string]any{
+		"varName":     varName,
+		"typeName":    typeName,
+		"key":         key,
+		"resValue":    nilOrDefault(toModelName),
+		"toTypeName":  toTypeName,
+		"toFieldName": toFieldName,
+		"cases":       cases,
+	}
+
+	return fillTemplate[string](mapValueSwitchFilePath, data)
+}
+
+func getMapValueConversion(conversionFunction, toModelName, key, index, toTypeName, toFieldName string,
+	withError bool) (string, error) {
+
+	data := map[string]any{
+		"conversionFunction": conversionFunction,
+		"resValue":           nilOrDefault(toModelName),
+		"key":                key,
+		"index":              index,
+		"toTypeName":         toTypeName,
+		"toFieldName":        toFieldName,
+		"withError":          withError,
+	}
+
+	return fillTemplate[string](mapValueConversionFilePath, data)
+}
+
+func getMapItemsConversion(typeName, key, toModelName, toTypeName, toFieldName, assignment string,
+	cases []mapValueCase) (string, error) {
+
+	data := map[string]any{
+		"typeName":    typeName,
+		"key":         key,
+		"resValue":    nilOrDefault(toModelName),
+		"toTypeName":  toTypeName,
+		"toFieldName": toFieldName,
+		"assignment":  assignment,
+		"cases":       cases,
+	}
+
+	return fillTemplate[string](mapItemsConversionFilePath, data)
+}
@@ -2,7 +2,9 @@ package generator
 
 import (
 	"errors"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/underbek/datamapper/models"
 )
@@ -10,6 +12,18 @@ import (
 var (
 	ErrNotFound                = errors.New("not found error")
 	ErrUndefinedConversionRule = errors.New("undefined conversion rule error")
+	ErrMethodWithoutReceiver   = errors.New("method conversion function without convertor struct error")
+	ErrNotFoundHook            = errors.New("not found hook error")
+	ErrComputedField           = errors.New("computed field error")
+	ErrMergeConflict           = errors.New("merged models conflict error")
+	ErrInterfaceTarget         = errors.New("interface target model error")
+	ErrConstruction            = errors.New("construction of target model error")
+)
+
+const (
+	convertorReceiverName = "c"
+	// dependencyNameSuffix is added to dependency field name which is keyword like type or map
+	dependencyNameSuffix = "Dependency"
 )
 
 type ConvertorType = string
@@ -23,9 +37,33 @@ type FieldsPair struct {
 	Assignment     string
 	Conversions    []string
 	WithError      bool
+	WithContext    bool
+	WithReceiver   bool
 	PointerToValue bool
 }
 
+// ConvertorOptions are additional options of generated convertor
+type ConvertorOptions struct {
+	// Receiver is convertor struct name. If it is empty then convertor is generated as function
+	Receiver string
+	// Hooks are user functions which can be called before and after conversion
+	Hooks models.Hooks
+	// BeforeHook and AfterHook are explicit hook names.
+	// By default hooks are found by convertor name like BeforeConvertUserToDTO and AfterConvertUserToDTO
+	BeforeHook string
+	AfterHook  string
+	// Validate enables call of Validate() method of result if it exists
+	Validate bool
+	// Computed are target fields computed by functions from some source fields
+	Computed []models.ComputedField
+	// Construct enables construction of target model by its constructor and setters
+	Construct bool
+	// Constructor is explicit constructor name. By default constructor is found by model name like NewUser
+	Constructor string
+	// ConstructorParams are tag values of target fields by constructor params names
+	ConstructorParams map[string]string
+}
+
 type result struct {
 	convertorName string
 	fromName      string
@@ -36,6 +74,20 @@ type result struct {
 	packages      models.Packages
 	conversions   []string
 	withError     bool
+	withContext   bool
+	receiver      string
+	beforeHook    string
+	afterHook     string
+	validate      string
+	// direct is type conversion expression used instead of fields assignments
+	direct string
+	// construction is creation of target model by constructor and setters used instead of fields assignments
+	construction string
+	// nilChecks are pointer source names which convertor returns nil for
+	nilChecks []string
+	// params and fromDescription are set if convertor has some source models
+	params          string
+	fromDescription string
 }
 
 func CreateConvertorSource(pkg models.Package, packages models.Packages, convertors []string, dest string) error {
@@ -62,15 +114,56 @@ func CreateConvertorSource(pkg models.Package, packages models.Packages, convert
 func GenerateConvertor(from, to models.Struct, pkg models.Package, functions models.Functions) (
 	models.GeneratedConversionFunction, error) {
 
-	res, err := createModelsPair(from, to, pkg.Path, functions)
+	return GenerateConvertorWithOptions(from, to, pkg, functions, ConvertorOptions{})
+}
+
+// GenerateConvertorWithOptions generates convertor as function or as method of receiver convertor struct
+// and calls found before and after hooks.
+func GenerateConvertorWithOptions(from, to models.Struct, pkg models.Package, functions models.Functions,
+	opts ConvertorOptions) (models.GeneratedConversionFunction, error) {
+
+	if to.Type.Kind == models.InterfaceType {
+		return models.GeneratedConversionFunction{}, fmt.Errorf(
+			"%w: convertor %s -> %s",
+			ErrInterfaceTarget,
+			from.Type.Name,
+			to.Type.Name,
+		)
+	}
+
+	var res result
+	var err error
+	if len(opts.Computed) == 0 && IsDirectConvertible(from, to) {
+		res, err = createDirectPair(from, to, pkg.Path)
+	} else {
+		res, err = createModelsPair(from, to, pkg.Path, functions, opts.Computed)
+	}
 	if err != nil {
 		return models.GeneratedConversionFunction{}, err
 	}
 
-	res.packages[from.Type.Package] = struct{}{}
-	res.packages[to.Type.Package] = struct{}{}
+	if opts.Receiver == "" && isUseReceiver(res.fields) {
+		return models.GeneratedConversionFunction{}, fmt.Errorf(
+			"%w: convertor %s -> %s",
+			ErrMethodWithoutReceiver,
+			from.Type.Name,
+			to.Type.Name,
+		)
+	}
+
+	if opts.Construct && res.direct == "" {
+		err = fillConstruction(&res, to, pkg.Path, opts)
+		if err != nil {
+			return models.GeneratedConversionFunction{}, err
+		}
+	}
+
+	for _, typePackage := range append(typePackages(from.Type), typePackages(to.Type)...) {
+		res.packages[typePackage] = struct{}{}
+	}
 
 	res.convertorName = generateConvertorName(from, to, pkg.Path)
+	res.receiver = opts.Receiver
 
 	res.fromName = from.Type.FullName(pkg.Path)
 	res.toName = to.Type.FullName(pkg.Path)
@@ -80,21 +173,281 @@ func GenerateConvertor(from, to models.Struct, pkg models.Package, functions mod
 
 	res.withError = res.withError || isReturnError(res.fields)
 
+	beforeHook, err := findHook(opts.Hooks, opts.BeforeHook, "Before"+res.convertorName, from.Type, to.Type, false)
+	if err != nil {
+		return models.GeneratedConversionFunction{}, err
+	}
+
+	afterHook, err := findHook(opts.Hooks, opts.AfterHook, "After"+res.convertorName, from.Type, to.Type, true)
+	if err != nil {
+		return models.GeneratedConversionFunction{}, err
+	}
+
+	for _, hook := range []*models.Hook{beforeHook, afterHook} {
+		if hook != nil {
+			res.withError = res.withError || hook.WithError
+			res.packages[hook.Package] = struct{}{}
+		}
+	}
+
+	if beforeHook != nil {
+		res.beforeHook, err = getHookCall(*beforeHook, "from", res.toName, pkg.Path)
+		if err != nil {
+			return models.GeneratedConversionFunction{}, err
+		}
+	}
+
+	if afterHook != nil {
+		resArg := "&res"
+		if to.Type.Pointer {
+			resArg = "res"
+		}
+
+		res.afterHook, err = getHookCall(*afterHook, "from, "+resArg, res.toName, pkg.Path)
+		if err != nil {
+			return models.GeneratedConversionFunction{}, err
+		}
+	}
+
+	if opts.Validate && to.WithValidate {
+		res.withError = true
+		res.validate, err = getValidateCall(res.toName)
+		if err != nil {
+			return models.GeneratedConversionFunction{}, err
+		}
+	}
+
+	res.withContext = isUseContext(res.fields)
+	if res.withContext {
+		res.packages[models.Package{
+			Name: "context",
+			Path: "context",
+		}] = struct{}{}
+	}
+
 	convertor, err := fillConvertor(res)
 	if err != nil {
 		return models.GeneratedConversionFunction{}, err
 	}
 
+	function := ConvertorDeclaration(from, to, pkg, opts.Receiver)
+	function.WithError = res.withError
+	function.WithContext = res.withContext
+
 	return models.GeneratedConversionFunction{
-		Function: models.ConversionFunction{
-			Name:      res.convertorName,
-			Package:   pkg,
-			FromType:  from.Type,
-			ToType:    to.Type,
-			TypeParam: models.NoTypeParam,
-			WithError: res.withError,
-		},
+		Function: function,
 		Packages: res.packages,
 		Body:     convertor,
 	}, nil
 }
+
+// ConvertorDeclaration returns conversion function of convertor without generation.
+// It is used as forward declaration of convertor by recursive models
+func ConvertorDeclaration(from, to models.Struct, pkg models.Package, receiver string) models.ConversionFunction {
+	function := models.ConversionFunction{
+		Name:      generateConvertorName(from, to, pkg.Path),
+		Package:   pkg,
+		FromType:  from.Type,
+		ToType:    to.Type,
+		TypeParam: models.NoTypeParam,
+	}
+
+	if receiver != "" {
+		function.Receiver = convertorReceiverName
+	}
+
+	return function
+}
+
+// GenerateMergedConvertor generates convertor of some source models into one target model.
+// Fields of each source model are found by its tag. Merged convertor is not a conversion function,
+// so it is not used by other convertors.
+func GenerateMergedConvertor(froms []models.Struct, to models.Struct, pkg models.Package,
+	functions models.Functions, opts ConvertorOptions) (string, models.Packages, error) {
+
+	sources := make([]source, 0, len(froms))
+	names := make(map[string]struct{}, len(froms))
+	params := make([]string, 0, len(froms))
+	descriptions := make([]string, 0, len(froms))
+	for _, from := range froms {
+		name := sourceName(from.Type, names)
+		names[name] = struct{}{}
+
+		sources = append(sources, source{name: name, model: from})
+		params = append(params, fmt.Sprintf("%s %s", name, from.Type.FullName(pkg.Path)))
+		descriptions = append(descriptions, fmt.Sprintf(
+			"%s by tag %s",
+			from.Type.FullName(pkg.Path),
+			from.Fields[0].Tags[0].Name,
+		))
+	}
+
+	res, err := createSourcesPair(sources, to, pkg.Path, functions, opts.Computed)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if opts.Receiver == "" && isUseReceiver(res.fields) {
+		return "", nil, fmt.Errorf(
+			"%w: convertor %s -> %s",
+			ErrMethodWithoutReceiver,
+			froms[0].Type.Name,
+			to.Type.Name,
+		)
+	}
+
+	for _, from := range froms {
+		res.packages[from.Type.Package] = struct{}{}
+	}
+	res.packages[to.Type.Package] = struct{}{}
+
+	res.convertorName = generateMergedConvertorName(froms, to, pkg.Path)
+	res.receiver = opts.Receiver
+	res.params = strings.Join(params, ", ")
+	res.fromDescription = strings.Join(descriptions, " and ")
+
+	res.toName = to.Type.FullName(pkg.Path)
+	res.toTag = to.Fields[0].Tags[0].Name
+
+	res.withError = res.withError || isReturnError(res.fields)
+
+	if opts.Validate && to.WithValidate {
+		res.withError = true
+		res.validate, err = getValidateCall(res.toName)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	res.withContext = isUseContext(res.fields)
+	if res.withContext {
+		res.packages[models.Package{
+			Name: "context",
+			Path: "context",
+		}] = struct{}{}
+	}
+
+	convertor, err := fillConvertor(res)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return convertor, res.packages, nil
+}
+
+// GenerateStructToMapConvertor generates convertor of model to map[string]any with keys by model tag values
+func GenerateStructToMapConvertor(from models.Struct, pkg models.Package, opts ConvertorOptions) (
+	string, models.Packages, error) {
+
+	res, err := createStructToMapPair(from, pkg.Path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	res.packages[from.Type.Package] = struct{}{}
+
+	res.convertorName = fmt.Sprintf("Convert%sToMap", structNameGenerator(from, pkg.Path))
+	res.receiver = opts.Receiver
+
+	res.fromName = from.Type.FullName(pkg.Path)
+	res.toName = MapModelName
+
+	res.fromTag = from.Fields[0].Tags[0].Name
+	res.toTag = res.fromTag
+
+	convertor, err := fillConvertor(res)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return convertor, res.packages, nil
+}
+
+// GenerateMapToStructConvertor generates convertor of map[string]any to model with keys by model tag values.
+// Values are type asserted or converted by conversion functions from string, float64, int64, int and bool.
+func GenerateMapToStructConvertor(to models.Struct, pkg models.Package, functions models.Functions,
+	opts ConvertorOptions) (string, models.Packages, error) {
+
+	res, err := createMapToStructPair(to, pkg.Path, functions)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if opts.Receiver == "" && isUseReceiver(res.fields) {
+		return "", nil, fmt.Errorf(
+			"%w: convertor %s -> %s",
+			ErrMethodWithoutReceiver,
+			MapModelName,
+			to.Type.Name,
+		)
+	}
+
+	res.packages[to.Type.Package] = struct{}{}
+
+	res.convertorName = fmt.Sprintf("ConvertMapTo%s", structNameGenerator(to, pkg.Path))
+	res.receiver = opts.Receiver
+
+	res.toName = to.Type.FullName(pkg.Path)
+	res.toTag = to.Fields[0].Tags[0].Name
+
+	res.fromName = MapModelName
+	res.fromDescription = MapModelName
+
+	if opts.Validate && to.WithValidate {
+		res.validate, err = getValidateCall(res.toName)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	res.withContext = isUseContext(res.fields)
+	if res.withContext {
+		res.packages[models.Package{
+			Name: "context",
+			Path: "context",
+		}] = struct{}{}
+	}
+
+	convertor, err := fillConvertor(res)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return convertor, res.packages, nil
+}
+
+// GenerateConvertorStruct generates convertor struct which holds conversion functions dependencies
+// and its constructor.
+func GenerateConvertorStruct(name string, pkg models.Package, dependencies []models.Type) (
+	string, models.Packages, error) {
+
+	packages := make(models.Packages)
+	for _, dependency := range dependencies {
+		packages[dependency.Package] = struct{}{}
+	}
+
+	body, err := fillConvertorStruct(name, pkg.Path, dependencies)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return body, packages, nil
+}
+
+// GenerateMapper generates interface with generated convertors and its default implementation.
+// If convertors are methods of convertor struct then the struct implements the interface.
+func GenerateMapper(name, convertor string, pkg models.Package, functions []models.ConversionFunction) (
+	string, error) {
+
+	return fillMapper(name, convertor, pkg.Path, functions)
+}
+
+// DependencyReceiver returns call receiver for methods of dependency in generated convertor struct.
+// Field name of dependency depends on all dependencies of convertor struct, because it must be unique
+func DependencyReceiver(dependency models.Type, dependencies []models.Type) string {
+	return fmt.Sprintf(
+		"%s.%s",
+		convertorReceiverName,
+		dependencyFieldNames(dependencies)[dependencyKey(dependency)],
+	)
+}
@@ -57,7 +57,7 @@ func Test_CreateModelsPair(t *testing.T) {
 		},
 	}
 
-	res, err := createModelsPair(fromModel, toModel, "", parseFunctions(t, cfPath))
+	res, err := createModelsPair(fromModel, toModel, "", parseFunctions(t, cfPath), nil)
 	require.NoError(t, err)
 
 	expected := result{
@@ -224,6 +224,13 @@ func Test_GenerateConvertor(t *testing.T) {
 			generatePath: "cf_with_slice_pointers_and_errors",
 			cfPath:       testGeneratorPath + "cf_with_slice_pointers_and_errors/cf",
 		},
+		{
+			name:         "Conversion functions with context",
+			pathFrom:     "cf_with_context",
+			pathTo:       "cf_with_context",
+			generatePath: "cf_with_context",
+			cfPath:       testGeneratorPath + "cf_with_context/cf",
+		},
 		{
 			name:          "With from pointer",
 			pathFrom:      "with_from_pointer",
@@ -249,6 +256,22 @@ func Test_GenerateConvertor(t *testing.T) {
 			isFromPointer: true,
 			isToPointer:   true,
 		},
+		{
+			name:         "Direct conversion",
+			pathFrom:     "direct_conversion",
+			pathTo:       "direct_conversion",
+			generatePath: "direct_conversion",
+			cfPath:       cfPath,
+		},
+		{
+			name:          "Direct conversion with pointers",
+			pathFrom:      "direct_conversion_with_pointers",
+			pathTo:        "direct_conversion_with_pointers",
+			generatePath:  "direct_conversion_with_pointers",
+			cfPath:        cfPath,
+			isFromPointer: true,
+			isToPointer:   true,
+		},
 	}
 
 	lg := logger.New()
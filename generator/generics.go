@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/underbek/datamapper/models"
+)
+
+var ErrNotGenericModel = errors.New("not generic model error")
+
+// InstantiateModel replaces type parameter of generic model fields by type argument of instantiation type
+// like Page[User]. Underlying type of instantiated model is unknown, so instantiations are not direct convertible
+func InstantiateModel(model models.Struct, t models.Type) (models.Struct, error) {
+	additional, ok := t.Additional.(models.GenericAdditional)
+	if !ok || len(model.TypeParams) != 1 {
+		return models.Struct{}, fmt.Errorf("%w: %s", ErrNotGenericModel, t.FullName(""))
+	}
+
+	fields := make([]models.Field, 0, len(model.Fields))
+	for _, field := range model.Fields {
+		field.Type = instantiateType(field.Type, additional.TypeArg)
+		fields = append(fields, field)
+	}
+
+	t.Pointer = false
+	model.Type = t
+	model.Fields = fields
+	model.TypeParams = nil
+	model.Underlying = nil
+
+	return model, nil
+}
+
+func instantiateType(t, typeArg models.Type) models.Type {
+	switch additional := t.Additional.(type) {
+	case models.SliceAdditional:
+		additional.InType = instantiateType(additional.InType, typeArg)
+		t.Additional = additional
+	case models.ArrayAdditional:
+		additional.InType = instantiateType(additional.InType, typeArg)
+		t.Additional = additional
+	case models.MapAdditional:
+		additional.KeyType = instantiateType(additional.KeyType, typeArg)
+		additional.ValueType = instantiateType(additional.ValueType, typeArg)
+		t.Additional = additional
+	case models.GenericAdditional:
+		additional.TypeArg = instantiateType(additional.TypeArg, typeArg)
+		t.Additional = additional
+	}
+
+	if t.Kind != models.TypeParameter {
+		return t
+	}
+
+	typeArg.Pointer = typeArg.Pointer || t.Pointer
+	return typeArg
+}
+
+// typePackages returns packages of type and type argument of generic type
+func typePackages(t models.Type) []models.Package {
+	res := []models.Package{t.Package}
+	if additional, ok := t.Additional.(models.GenericAdditional); ok {
+		res = append(res, typePackages(additional.TypeArg)...)
+	}
+
+	return res
+}
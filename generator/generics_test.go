@@ -0,0 +1,58 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_InstantiateModel(t *testing.T) {
+	pkg := models.Package{Name: "generic", Path: "github.com/underbek/generic"}
+	typeParam := models.Type{Name: "T", Kind: models.TypeParameter}
+	pointerTypeParam := typeParam
+	pointerTypeParam.Pointer = true
+
+	page := models.Struct{
+		Type: models.Type{Name: "Page", Package: pkg, Kind: models.StructType},
+		Fields: []models.Field{
+			{Name: "Items", Type: models.Type{
+				Kind:       models.SliceType,
+				Additional: models.SliceAdditional{InType: typeParam},
+			}},
+			{Name: "First", Type: pointerTypeParam},
+			{Name: "Total", Type: models.Type{Name: "int", Kind: models.BaseType}},
+		},
+		TypeParams: []string{"T"},
+	}
+
+	user := models.Type{Name: "User", Package: pkg, Kind: models.StructType}
+	pageOfUser := page.Type
+	pageOfUser.Pointer = true
+	pageOfUser.Additional = models.GenericAdditional{TypeArg: user}
+
+	res, err := InstantiateModel(page, pageOfUser)
+	require.NoError(t, err)
+
+	pageOfUser.Pointer = false
+	pointerUser := user
+	pointerUser.Pointer = true
+
+	assert.Equal(t, models.Struct{
+		Type: pageOfUser,
+		Fields: []models.Field{
+			{Name: "Items", Type: models.Type{
+				Kind:       models.SliceType,
+				Additional: models.SliceAdditional{InType: user},
+			}},
+			{Name: "First", Type: pointerUser},
+			{Name: "Total", Type: models.Type{Name: "int", Kind: models.BaseType}},
+		},
+	}, res)
+
+	assert.Equal(t, "generic.Page[generic.User]", res.Type.FullName(""))
+
+	_, err = InstantiateModel(page, page.Type)
+	require.ErrorIs(t, err, ErrNotGenericModel)
+}
@@ -2,6 +2,9 @@ package generator
 
 import (
 	"fmt"
+	"go/token"
+	"strings"
+	"unicode"
 
 	"github.com/underbek/datamapper/models"
 	"golang.org/x/exp/maps"
@@ -41,6 +44,138 @@ func isReturnError(fields []FieldsPair) bool {
 	return false
 }
 
+func isUseContext(fields []FieldsPair) bool {
+	for _, field := range fields {
+		if field.WithContext {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isUseReceiver(fields []FieldsPair) bool {
+	for _, field := range fields {
+		if field.WithReceiver {
+			return true
+		}
+	}
+
+	return false
+}
+
+// dependencyFieldNames returns unique field names of convertor struct dependencies by dependency keys.
+// Names are type names with lowered leading word like urlResolver for URLResolver.
+// Types with the same names of different packages are prefixed by package names,
+// keywords are suffixed and other conflicts are numbered
+func dependencyFieldNames(dependencies []models.Type) map[string]string {
+	paths := make(map[string]map[string]struct{}, len(dependencies))
+	for _, dependency := range dependencies {
+		if paths[dependency.Name] == nil {
+			paths[dependency.Name] = make(map[string]struct{})
+		}
+		paths[dependency.Name][dependency.Package.Path] = struct{}{}
+	}
+
+	names := make(map[string]string, len(dependencies))
+	used := make(map[string]struct{}, len(dependencies))
+	for _, dependency := range dependencies {
+		key := dependencyKey(dependency)
+		if _, ok := names[key]; ok {
+			continue
+		}
+
+		name := lowerLeadingWord(dependency.Name)
+		if len(paths[dependency.Name]) > 1 {
+			name = lowerLeadingWord(dependency.Package.Name) + dependency.Name
+		}
+
+		if token.IsKeyword(name) {
+			name += dependencyNameSuffix
+		}
+
+		unique := name
+		for i := 2; ; i++ {
+			if _, ok := used[unique]; !ok {
+				break
+			}
+			unique = fmt.Sprintf("%s%d", name, i)
+		}
+
+		used[unique] = struct{}{}
+		names[key] = unique
+	}
+
+	return names
+}
+
+func isPluralAcronym(runes []rune, upper int) bool {
+	return runes[upper] == 's' && (upper+1 == len(runes) || unicode.IsUpper(runes[upper+1]))
+}
+
+func dependencyKey(dependency models.Type) string {
+	return fmt.Sprintf("%s.%s", dependency.Package.Path, dependency.Name)
+}
+
+// lowerLeadingWord lowers first letter or whole leading acronym like URL in URLResolver
+func lowerLeadingWord(name string) string {
+	runes := []rune(name)
+
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+
+	// last upper letter of acronym is first letter of next word except of plural acronym like IDs
+	if upper > 1 && upper < len(runes) && unicode.IsLower(runes[upper]) && !isPluralAcronym(runes, upper) {
+		upper--
+	}
+
+	if upper == 0 {
+		upper = 1
+	}
+
+	return cases.Lower(language.Und).String(string(runes[:upper])) + string(runes[upper:])
+}
+
+// findHook returns hook by explicit name or by default name if it exists and matches convertor types.
+// Explicit hook must exist and match.
+func findHook(hooks models.Hooks, explicitName, defaultName string, from, to models.Type, after bool) (
+	*models.Hook, error) {
+
+	name := defaultName
+	if explicitName != "" {
+		name = explicitName
+	}
+
+	hook, ok := hooks[name]
+	if ok && hook.IsAfter() == after && isHookMatched(hook, from, to) {
+		return &hook, nil
+	}
+
+	if explicitName == "" {
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s for %s -> %s", ErrNotFoundHook, explicitName, from.Name, to.Name)
+}
+
+func isHookMatched(hook models.Hook, from, to models.Type) bool {
+	if !isSameTypeByName(hook.FromType, from) || hook.FromType.Pointer != from.Pointer {
+		return false
+	}
+
+	if !hook.IsAfter() {
+		return true
+	}
+
+	return isSameTypeByName(hook.ToType, to)
+}
+
+func isSameTypeByName(first, second models.Type) bool {
+	return first.Name == second.Name && first.Package.Path == second.Package.Path
+}
+
 func filterAndSortImports(currentPkgPath string, imports []ImportType) []ImportType {
 	set := make(map[ImportType]struct{})
 	for _, imp := range imports {
@@ -56,27 +191,57 @@ func filterAndSortImports(currentPkgPath string, imports []ImportType) []ImportT
 }
 
 func generateConvertorName(from, to models.Struct, pkgPath string) string {
-	structNameGenerator := func(model models.Struct, pkgPath string) string {
-		name := model.Type.Name
-
-		if model.Type.Package.Path == pkgPath {
-			return name
-		}
+	return fmt.Sprintf(
+		"Convert%sTo%s",
+		structNameGenerator(from, pkgPath),
+		structNameGenerator(to, pkgPath),
+	)
+}
 
-		pkgName := model.Type.Package.Name
-		if model.Type.Package.Alias != "" {
-			pkgName = model.Type.Package.Alias
-		}
-		return cases.Title(language.Und, cases.NoLower).String(pkgName) + name
+func generateMergedConvertorName(froms []models.Struct, to models.Struct, pkgPath string) string {
+	names := make([]string, 0, len(froms))
+	for _, from := range froms {
+		names = append(names, structNameGenerator(from, pkgPath))
 	}
 
 	return fmt.Sprintf(
 		"Convert%sTo%s",
-		structNameGenerator(from, pkgPath),
+		strings.Join(names, "And"),
 		structNameGenerator(to, pkgPath),
 	)
 }
 
+// sourceName returns variable name of source model in merged convertor
+func sourceName(t models.Type, names map[string]struct{}) string {
+	name := cases.Lower(language.Und).String(t.Name[:1]) + t.Name[1:]
+	if _, ok := names[name]; ok && t.Package.Name != "" {
+		name = cases.Lower(language.Und).String(t.Package.Name) + t.Name
+	}
+
+	if token.IsKeyword(name) || name == "ctx" || name == "res" || name == "err" || name == "c" {
+		name += "Model"
+	}
+
+	return name
+}
+
+func structNameGenerator(model models.Struct, pkgPath string) string {
+	name := model.Type.Name
+	if additional, ok := model.Type.Additional.(models.GenericAdditional); ok {
+		name += "Of" + chainTypeName(additional.TypeArg, pkgPath)
+	}
+
+	if model.Type.Package.Path == pkgPath {
+		return name
+	}
+
+	pkgName := model.Type.Package.Name
+	if model.Type.Package.Alias != "" {
+		pkgName = model.Type.Package.Alias
+	}
+	return cases.Title(language.Und, cases.NoLower).String(pkgName) + name
+}
+
 func isSameTypesWithoutPointer(from, to models.Type) bool {
 	from.Pointer = false
 	to.Pointer = false
@@ -84,6 +249,56 @@ func isSameTypesWithoutPointer(from, to models.Type) bool {
 	return from == to
 }
 
+// typeFullName returns full name of type including slices, arrays and maps
+func typeFullName(t models.Type, pkgPath string) string {
+	ptr := ""
+	if t.Pointer {
+		ptr = "*"
+	}
+
+	switch additional := t.Additional.(type) {
+	case models.SliceAdditional:
+		return fmt.Sprintf("%s[]%s", ptr, typeFullName(additional.InType, pkgPath))
+	case models.ArrayAdditional:
+		return fmt.Sprintf("%s[%d]%s", ptr, additional.Len, typeFullName(additional.InType, pkgPath))
+	case models.MapAdditional:
+		return fmt.Sprintf(
+			"%smap[%s]%s",
+			ptr,
+			typeFullName(additional.KeyType, pkgPath),
+			typeFullName(additional.ValueType, pkgPath),
+		)
+	default:
+		return t.FullName(pkgPath)
+	}
+}
+
+// isSameTypesWithoutAlias compares types ignoring package aliases
+func isSameTypesWithoutAlias(first, second models.Type) bool {
+	return clearTypeAlias(first) == clearTypeAlias(second)
+}
+
+func clearTypeAlias(t models.Type) models.Type {
+	t.Package.Alias = ""
+	switch additional := t.Additional.(type) {
+	case models.SliceAdditional:
+		additional.InType = clearTypeAlias(additional.InType)
+		t.Additional = additional
+	case models.ArrayAdditional:
+		additional.InType = clearTypeAlias(additional.InType)
+		t.Additional = additional
+	case models.MapAdditional:
+		additional.KeyType = clearTypeAlias(additional.KeyType)
+		additional.ValueType = clearTypeAlias(additional.ValueType)
+		t.Additional = additional
+	case models.GenericAdditional:
+		additional.TypeArg = clearTypeAlias(additional.TypeArg)
+		t.Additional = additional
+	}
+
+	return t
+}
+
 func getConversionFunction(fromType, toType models.Type, fromName string, functions models.Functions,
 ) (models.ConversionFunction, error) {
 
@@ -140,6 +355,14 @@ func getConversionFunctionCall(cf models.ConversionFunction, fromFieldType, toFi
 	ptr := getPointerSymbol(fromFieldType, cf.FromType)
 	typeParams := getTypeParams(cf, fromFieldType, toFieldType)
 
+	if cf.WithContext {
+		ptr = "ctx, " + ptr
+	}
+
+	if cf.Receiver != "" {
+		return fmt.Sprintf("%s.%s%s(%s%s)", cf.Receiver, cf.Name, typeParams, ptr, arg)
+	}
+
 	if cf.Package.Path == pkgPath {
 		return fmt.Sprintf("%s%s(%s%s)", cf.Name, typeParams, ptr, arg)
 	}
@@ -147,6 +370,13 @@ func getConversionFunctionCall(cf models.ConversionFunction, fromFieldType, toFi
 	return fmt.Sprintf("%s.%s%s(%s%s)", packageName, cf.Name, typeParams, ptr, arg)
 }
 
+func getModelPointerCheckError(fromModelName, toModelName string) string {
+	return fmt.Sprintf(`errors.New("cannot convert %s -> %s, model is nil")`,
+		fromModelName,
+		toModelName,
+	)
+}
+
 func getFieldPointerCheckError(fromModelName, toModelName, fromFieldName, toFieldName string) string {
 	return fmt.Sprintf(`errors.New("cannot convert %s.%s -> %s.%s, field is nil")`,
 		fromModelName,
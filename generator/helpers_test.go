@@ -0,0 +1,128 @@
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_DependencyFieldNames(t *testing.T) {
+	dependency := func(path, name string) models.Type {
+		return models.Type{
+			Name:    name,
+			Package: models.Package{Path: "github.com/user/" + path, Name: path},
+			Kind:    models.StructType,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		dependencies []models.Type
+		expected     []string
+	}{
+		{
+			name:         "simple name",
+			dependencies: []models.Type{dependency("rates", "Rates")},
+			expected:     []string{"rates"},
+		},
+		{
+			name: "leading acronym",
+			dependencies: []models.Type{
+				dependency("resolver", "URLResolver"),
+				dependency("resolver", "URL"),
+				dependency("resolver", "IDs"),
+				dependency("resolver", "A"),
+			},
+			expected: []string{"urlResolver", "url", "ids", "a"},
+		},
+		{
+			name: "keywords",
+			dependencies: []models.Type{
+				dependency("types", "Type"),
+				dependency("types", "Map"),
+				dependency("types", "Func"),
+			},
+			expected: []string{"typeDependency", "mapDependency", "funcDependency"},
+		},
+		{
+			name: "same names of different packages",
+			dependencies: []models.Type{
+				dependency("rates", "Client"),
+				dependency("users", "Client"),
+				dependency("users", "Storage"),
+			},
+			expected: []string{"ratesClient", "usersClient", "storage"},
+		},
+		{
+			name: "same names and packages names",
+			dependencies: []models.Type{
+				dependency("rates", "Client"),
+				{
+					Name:    "Client",
+					Package: models.Package{Path: "github.com/other/rates", Name: "rates"},
+					Kind:    models.StructType,
+				},
+			},
+			expected: []string{"ratesClient", "ratesClient2"},
+		},
+		{
+			name: "conflict with other name",
+			dependencies: []models.Type{
+				dependency("rates", "Client"),
+				dependency("users", "Client"),
+				dependency("users", "RatesClient"),
+			},
+			expected: []string{"ratesClient", "usersClient", "ratesClient2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			names := dependencyFieldNames(tt.dependencies)
+
+			actual := make([]string, 0, len(tt.dependencies))
+			for _, dep := range tt.dependencies {
+				actual = append(actual, names[dependencyKey(dep)])
+			}
+
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_FillConvertorStructWithKeywordDependencies(t *testing.T) {
+	dependencies := []models.Type{
+		{
+			Name:    "Type",
+			Package: models.Package{Path: "github.com/user/types", Name: "types"},
+			Kind:    models.StructType,
+			Pointer: true,
+		},
+		{
+			Name:    "Type",
+			Package: models.Package{Path: "github.com/user/kinds", Name: "kinds"},
+			Kind:    models.StructType,
+			Pointer: true,
+		},
+		{
+			Name:    "Map",
+			Package: models.Package{Path: "github.com/user/types", Name: "types"},
+			Kind:    models.StructType,
+			Pointer: true,
+		},
+	}
+
+	body, err := fillConvertorStruct("Convertor", "github.com/user/mapper", dependencies)
+	require.NoError(t, err)
+
+	assert.Contains(t, body, "typesType *types.Type\n")
+	assert.Contains(t, body, "kindsType *kinds.Type\n")
+	assert.Contains(t, body, "mapDependency *types.Map\n")
+	assert.Contains(t, body,
+		"func NewConvertor(typesType *types.Type, kindsType *kinds.Type, mapDependency *types.Map) *Convertor {")
+
+	receiver := DependencyReceiver(dependencies[2], dependencies)
+	assert.Equal(t, "c.mapDependency", receiver)
+}
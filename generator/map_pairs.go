@@ -0,0 +1,222 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/underbek/datamapper/models"
+)
+
+// MapModelName is a name of model which is converted to struct and from struct by tags
+const MapModelName = "map[string]any"
+
+// dynamicTypes are types of map values which can be converted to fields types by conversion functions
+var dynamicTypes = []models.Type{
+	{Name: "string"},
+	{Name: "float64"},
+	{Name: "int64"},
+	{Name: "int"},
+	{Name: "bool"},
+}
+
+type mapValueCase struct {
+	Type string
+	Body string
+}
+
+// createStructToMapPair creates map items by model fields with keys by tag values
+func createStructToMapPair(from models.Struct, pkgPath string) (result, error) {
+	packages := make(models.Packages)
+
+	var conversions []string
+	var withError bool
+	if from.Type.Pointer {
+		conversion, err := getPointerCheck(
+			"from",
+			MapModelName,
+			fmt.Sprintf("errors.New(\"%s is nil\")", from.Type.Name),
+		)
+		if err != nil {
+			return result{}, err
+		}
+
+		conversions = append(conversions, conversion)
+		packages[models.Package{
+			Name: "errors",
+			Path: "errors",
+		}] = struct{}{}
+
+		withError = true
+	}
+
+	fields := make([]FieldsPair, 0, len(from.Fields))
+	for _, field := range from.Fields {
+		fields = append(fields, FieldsPair{
+			FromName:   field.Name,
+			FromType:   field.Type.Name,
+			ToName:     strconv.Quote(field.Tags[0].Value),
+			Assignment: fmt.Sprintf("from.%s", field.Name),
+		})
+	}
+
+	return result{
+		fields:      fields,
+		packages:    packages,
+		conversions: conversions,
+		withError:   withError,
+	}, nil
+}
+
+// createMapToStructPair creates model fields by map values with keys by tag values.
+// Values are type asserted to field type or converted by conversion functions from dynamic types.
+func createMapToStructPair(to models.Struct, pkgPath string, functions models.Functions) (result, error) {
+	packages := map[models.Package]struct{}{
+		{Name: "fmt", Path: "fmt"}: {},
+	}
+
+	toModelName := to.Type.FullName(pkgPath)
+
+	fields := make([]FieldsPair, 0, len(to.Fields))
+	for _, field := range to.Fields {
+		key := field.Tags[0].Value
+		varName := fmt.Sprintf("from%s", field.Name)
+
+		valueType := field.Type
+		valueType.Pointer = false
+
+		pair := FieldsPair{
+			FromName:   key,
+			ToName:     field.Name,
+			ToType:     field.Type.Name,
+			Assignment: varName,
+			WithError:  true,
+		}
+
+		assign := func(value string) string {
+			if field.Type.Pointer {
+				return fmt.Sprintf("%s = &%s", varName, value)
+			}
+			return fmt.Sprintf("%s = %s", varName, value)
+		}
+
+		cases := []mapValueCase{{Type: typeFullName(valueType, pkgPath), Body: assign("v")}}
+		if field.Type.Pointer {
+			cases = append(cases, mapValueCase{Type: typeFullName(field.Type, pkgPath), Body: varName + " = v"})
+		}
+
+		valueCases, err := mapValueCases(valueType, field.Type.Pointer, assign, "", &pair, packages, to, pkgPath,
+			functions)
+		if err != nil {
+			return result{}, err
+		}
+
+		cases = append(cases, valueCases...)
+
+		itemsCase, ok, err := mapItemsCase(valueType, assign, &pair, packages, to, pkgPath, functions)
+		if err != nil {
+			return result{}, err
+		}
+
+		if ok {
+			cases = append(cases, itemsCase)
+		}
+
+		conversion, err := getMapValueSwitch(varName, typeFullName(field.Type, pkgPath), key, toModelName,
+			to.Type.Name, field.Name, cases)
+		if err != nil {
+			return result{}, err
+		}
+
+		pair.Conversions = []string{conversion}
+		packages[valueType.Package] = struct{}{}
+		fields = append(fields, pair)
+	}
+
+	return result{
+		fields:      fields,
+		packages:    packages,
+		conversions: fillConversions(fields),
+		withError:   true,
+	}, nil
+}
+
+// mapValueCases creates type switch cases of dynamic types converted to value type by conversion functions.
+// Index is a variable of slice item index added to conversion errors, it is empty for map values
+func mapValueCases(valueType models.Type, pointer bool, assign func(string) string, index string, pair *FieldsPair,
+	packages models.Packages, to models.Struct, pkgPath string, functions models.Functions) ([]mapValueCase, error) {
+
+	toModelName := to.Type.FullName(pkgPath)
+
+	var cases []mapValueCase
+	for _, dynamicType := range dynamicTypes {
+		if isSameTypesWithoutPointer(dynamicType, valueType) {
+			continue
+		}
+
+		cf, ok := functions[models.ConversionFunctionKey{FromType: dynamicType, ToType: valueType}]
+		if !ok {
+			continue
+		}
+
+		if cf.Package.Path != "" {
+			packages[cf.Package] = struct{}{}
+		}
+
+		pair.WithContext = pair.WithContext || cf.WithContext
+		pair.WithReceiver = pair.WithReceiver || cf.Receiver != ""
+
+		call := getConversionFunctionCall(cf, dynamicType, valueType, pkgPath, "v")
+		body := assign(call)
+		if cf.WithError || pointer {
+			conversion, err := getMapValueConversion(call, toModelName, pair.FromName, index, to.Type.Name,
+				pair.ToName, cf.WithError)
+			if err != nil {
+				return nil, err
+			}
+
+			body = conversion + assign("res")
+		}
+
+		cases = append(cases, mapValueCase{Type: dynamicType.Name, Body: body})
+	}
+
+	return cases, nil
+}
+
+// mapItemsCase creates type switch case of []any value like decoded by json.Unmarshal.
+// Items are type asserted to slice item type or converted by conversion functions from dynamic types.
+// Slices of interfaces are assigned as is by value type case
+func mapItemsCase(valueType models.Type, assign func(string) string, pair *FieldsPair, packages models.Packages,
+	to models.Struct, pkgPath string, functions models.Functions) (mapValueCase, bool, error) {
+
+	additional, ok := valueType.Additional.(models.SliceAdditional)
+	if !ok || valueType.Kind != models.SliceType || additional.InType.Pointer ||
+		additional.InType.Kind == models.InterfaceType {
+		return mapValueCase{}, false, nil
+	}
+
+	appendItem := func(value string) string {
+		return fmt.Sprintf("items = append(items, %s)", value)
+	}
+
+	itemType := additional.InType
+	cases := []mapValueCase{{Type: typeFullName(itemType, pkgPath), Body: appendItem("v")}}
+
+	itemCases, err := mapValueCases(itemType, false, appendItem, "i", pair, packages, to, pkgPath, functions)
+	if err != nil {
+		return mapValueCase{}, false, err
+	}
+
+	cases = append(cases, itemCases...)
+	if itemType.Package.Path != "" {
+		packages[itemType.Package] = struct{}{}
+	}
+
+	body, err := getMapItemsConversion(typeFullName(valueType, pkgPath), pair.FromName,
+		to.Type.FullName(pkgPath), to.Type.Name, pair.ToName, assign("items"), cases)
+	if err != nil {
+		return mapValueCase{}, false, err
+	}
+
+	return mapValueCase{Type: "[]any", Body: body}, true, nil
+}
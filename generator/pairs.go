@@ -2,62 +2,106 @@ package generator
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/underbek/datamapper/models"
 	"golang.org/x/exp/maps"
 )
 
-func createModelsPair(from, to models.Struct, pkgPath string, functions models.Functions) (result, error) {
-	var fields []FieldsPair
-	packages := make(models.Packages)
+// source is a source model with its variable name in convertor
+type source struct {
+	name  string
+	model models.Struct
+}
 
-	var conversions []string
-	var withError bool
-	if from.Type.Pointer && !to.Type.Pointer {
-		conversion, err := getPointerCheck(
-			"from",
-			to.Type.FullName(pkgPath),
-			fmt.Sprintf("errors.New(\"%s is nil\")", from.Type.Name),
-		)
-		if err != nil {
-			return result{}, err
-		}
+// sourceField is a tagged field of source model
+type sourceField struct {
+	source
+	field models.Field
+}
 
-		conversions = append(conversions, conversion)
-		packages[models.Package{
-			Name: "errors",
-			Path: "errors",
-		}] = struct{}{}
+func createModelsPair(from, to models.Struct, pkgPath string, functions models.Functions,
+	computed []models.ComputedField) (result, error) {
 
-		withError = true
+	return createSourcesPair([]source{{name: "from", model: from}}, to, pkgPath, functions, computed)
+}
+
+// createSourcesPair creates fields pairs of target model and fields of some source models.
+// Each tag must be provided by one source model.
+func createSourcesPair(sources []source, to models.Struct, pkgPath string, functions models.Functions,
+	computed []models.ComputedField) (result, error) {
+
+	var fields []FieldsPair
+	res, err := getSourcesPointerChecks(sources, to, pkgPath)
+	if err != nil {
+		return result{}, err
 	}
 
-	if from.Type.Pointer && to.Type.Pointer {
-		conversion, err := getPointerCheck(
-			"from",
-			to.Type.FullName(pkgPath),
-			"nil",
-		)
-		if err != nil {
-			return result{}, err
+	packages := res.packages
+
+	// tags provided by some sources are conflicts only if they are used by target fields or computed fields
+	usedTags := make(map[string]struct{}, len(to.Fields))
+	for _, field := range to.Fields {
+		usedTags[field.Tags[0].Value] = struct{}{}
+	}
+	for _, field := range computed {
+		for _, arg := range field.Args {
+			usedTags[arg] = struct{}{}
 		}
+	}
 
-		conversions = append(conversions, conversion)
+	fromFields := make(map[string]sourceField)
+	for _, src := range sources {
+		for _, field := range src.model.Fields {
+			tag := field.Tags[0].Value
+			if _, ok := usedTags[tag]; !ok {
+				continue
+			}
+
+			if prev, ok := fromFields[tag]; ok && prev.name != src.name {
+				return result{}, fmt.Errorf(
+					"%w: tag %s is provided by %s.%s and %s.%s",
+					ErrMergeConflict,
+					tag,
+					prev.model.Type.Name,
+					prev.field.Name,
+					src.model.Type.Name,
+					field.Name,
+				)
+			}
+
+			fromFields[tag] = sourceField{source: src, field: field}
+		}
 	}
 
-	fromFields := make(map[string]models.Field)
-	for _, field := range from.Fields {
-		fromFields[field.Tags[0].Value] = field
+	computedFields := make(map[string]models.ComputedField, len(computed))
+	for _, field := range computed {
+		computedFields[field.Field] = field
 	}
 
+	toFields := make(map[string]struct{}, len(to.Fields))
 	for _, toField := range to.Fields {
+		toFields[toField.Tags[0].Value] = struct{}{}
+
+		if field, ok := computedFields[toField.Tags[0].Value]; ok {
+			pair, packs, err := getComputedFieldsPair(field, fromFields, toField, to, pkgPath)
+			if err != nil {
+				return result{}, err
+			}
+
+			maps.Copy(packages, packs)
+			fields = append(fields, pair)
+			continue
+		}
+
 		fromField, ok := fromFields[toField.Tags[0].Value]
 		if !ok {
 			//TODO: warning or error politics
 			continue
 		}
 
-		pair, packs, err := getFieldsPair(fromField, toField, from, to, pkgPath, functions)
+		pair, packs, err := getFieldsPair(fromField.field, toField, fromField.model, to, pkgPath, functions,
+			fromField.name)
 		if err != nil {
 			return result{}, err
 		}
@@ -66,18 +110,70 @@ func createModelsPair(from, to models.Struct, pkgPath string, functions models.F
 		fields = append(fields, pair)
 	}
 
-	conversions = append(conversions, fillConversions(fields)...)
+	for _, field := range computed {
+		if _, ok := toFields[field.Field]; !ok {
+			return result{}, fmt.Errorf(
+				"%w: model %s does not contain field with tag %s",
+				ErrComputedField,
+				to.Type.Name,
+				field.Field,
+			)
+		}
+	}
+
+	res.fields = fields
+	res.conversions = append(res.conversions, fillConversions(fields)...)
 
-	return result{
-		fields:      fields,
-		packages:    packages,
-		conversions: conversions,
-		withError:   withError,
-	}, nil
+	return res, nil
+}
+
+// getSourcesPointerChecks creates nil checks of pointer source models.
+// If target model is pointer then nil checks are filled with convertor, because their result depends on its error.
+// Otherwise models read by nil-safe getters are not checked
+func getSourcesPointerChecks(sources []source, to models.Struct, pkgPath string) (result, error) {
+	res := result{
+		packages: make(models.Packages),
+	}
+
+	for _, src := range sources {
+		if !src.model.Type.Pointer {
+			continue
+		}
+
+		if to.Type.Pointer {
+			res.nilChecks = append(res.nilChecks, src.name)
+			continue
+		}
+
+		// nil-safe getters are called on nil source model
+		if isReadByGetters(src.model) {
+			continue
+		}
+
+		nilError := fmt.Sprintf("errors.New(\"%s is nil\")", src.model.Type.Name)
+		if len(sources) > 1 {
+			nilError = getModelPointerCheckError(src.model.Type.Name, to.Type.Name)
+		}
+
+		conversion, err := getPointerCheck(src.name, to.Type.FullName(pkgPath), nilError)
+		if err != nil {
+			return result{}, err
+		}
+
+		res.conversions = append(res.conversions, conversion)
+		res.packages[models.Package{
+			Name: "errors",
+			Path: "errors",
+		}] = struct{}{}
+
+		res.withError = true
+	}
+
+	return res, nil
 }
 
 func getFieldsPair(from, to models.Field, fromModel, toModel models.Struct, pkgPath string, functions models.Functions,
-) (FieldsPair, models.Packages, error) {
+	source string) (FieldsPair, models.Packages, error) {
 
 	cf, err := getConversionFunction(from.Type, to.Type, from.Name, functions)
 	if err != nil {
@@ -85,14 +181,119 @@ func getFieldsPair(from, to models.Field, fromModel, toModel models.Struct, pkgP
 	}
 
 	res := FieldsPair{
-		FromName:  from.Name,
-		FromType:  from.Type.Name,
-		ToName:    to.Name,
-		ToType:    to.Type.Name,
-		WithError: cf.WithError,
+		FromName:     from.Name,
+		FromType:     from.Type.Name,
+		ToName:       to.Name,
+		ToType:       to.Type.Name,
+		WithError:    cf.WithError,
+		WithContext:  cf.WithContext,
+		WithReceiver: cf.Receiver != "",
+	}
+
+	return fillConversionFunction(res, from, to, fromModel, toModel, cf, pkgPath, source)
+}
+
+// getComputedFieldsPair fills target field by computed function call with source fields as arguments
+func getComputedFieldsPair(field models.ComputedField, fromFields map[string]sourceField, to models.Field,
+	toModel models.Struct, pkgPath string) (FieldsPair, models.Packages, error) {
+
+	function := field.Function
+	if len(function.ArgTypes) != len(field.Args) {
+		return FieldsPair{}, nil, fmt.Errorf(
+			"%w: function %s has %d params but %d fields are set",
+			ErrComputedField,
+			function.Name,
+			len(function.ArgTypes),
+			len(field.Args),
+		)
+	}
+
+	args := make([]string, 0, len(field.Args)+1)
+	if function.WithContext {
+		args = append(args, "ctx")
+	}
+
+	fromNames := make([]string, 0, len(field.Args))
+	for i, arg := range field.Args {
+		fromField, ok := fromFields[arg]
+		if !ok {
+			return FieldsPair{}, nil, fmt.Errorf(
+				"%w: source models do not contain field with tag %s",
+				ErrComputedField,
+				arg,
+			)
+		}
+
+		if !isSameTypesWithoutAlias(fromField.field.Type, function.ArgTypes[i]) {
+			return FieldsPair{}, nil, fmt.Errorf(
+				"%w: field %s.%s has type %s but function %s param has type %s",
+				ErrComputedField,
+				fromField.model.Type.Name,
+				fromField.field.Name,
+				fromField.field.Type.FullName(pkgPath),
+				function.Name,
+				function.ArgTypes[i].FullName(pkgPath),
+			)
+		}
+
+		args = append(args, fieldAccess(fromField.name, fromField.field))
+		fromNames = append(fromNames, fromField.field.Name)
+	}
+
+	if !isSameTypesWithoutAlias(to.Type, function.ToType) {
+		return FieldsPair{}, nil, fmt.Errorf(
+			"%w: field %s.%s has type %s but function %s returns %s",
+			ErrComputedField,
+			toModel.Type.Name,
+			to.Name,
+			to.Type.FullName(pkgPath),
+			function.Name,
+			function.ToType.FullName(pkgPath),
+		)
+	}
+
+	pkgs := make(models.Packages)
+	pkgs[function.Package] = struct{}{}
+
+	call := fmt.Sprintf(
+		"%s(%s)",
+		models.Type{Name: function.Name, Package: function.Package}.FullName(pkgPath),
+		strings.Join(args, ", "),
+	)
+
+	pair := FieldsPair{
+		FromName:    strings.Join(fromNames, ", "),
+		ToName:      to.Name,
+		ToType:      to.Type.Name,
+		Assignment:  call,
+		WithError:   function.WithError,
+		WithContext: function.WithContext,
+	}
+
+	if !function.WithError {
+		return pair, pkgs, nil
+	}
+
+	errString, err := getComputeError(toModel.Type.Name, to.Name, function.Name)
+	if err != nil {
+		return FieldsPair{}, nil, err
+	}
+
+	pkgs[models.Package{
+		Name: "fmt",
+		Path: "fmt",
+	}] = struct{}{}
+
+	resName := fmt.Sprintf("computed%s", to.Name)
+	conversion, err := getErrorConversion(resName, toModel.Type.FullName(pkgPath), call, errString)
+	if err != nil {
+		return FieldsPair{}, nil, err
 	}
 
-	return fillConversionFunction(res, from, to, fromModel, toModel, cf, pkgPath)
+	pair.Conversions = []string{conversion}
+	pair.Assignment = resName
+
+	return pair, pkgs, nil
 }
 
 func getAssigmentBySameTypes(fromFieldFullName string, fromType, toType models.Type) string {
@@ -108,7 +309,7 @@ func getAssigmentBySameTypes(fromFieldFullName string, fromType, toType models.T
 }
 
 func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fromModel, toModel models.Struct,
-	cf models.ConversionFunction, pkgPath string) (FieldsPair, models.Packages, error) {
+	cf models.ConversionFunction, pkgPath, source string) (FieldsPair, models.Packages, error) {
 	pkgs := make(models.Packages)
 	if cf.Package.Path != "" {
 		pkgs[cf.Package] = struct{}{}
@@ -119,15 +320,15 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 		fromField.Type,
 		toField.Type,
 		pkgPath,
-		fmt.Sprintf("from.%s", fromField.Name),
+		fieldAccess(source, fromField),
 	)
 
-	refAssignment := fmt.Sprintf("&from%s", fromField.Name)
-	valueAssignment := fmt.Sprintf("from%s", fromField.Name)
+	refAssignment := fmt.Sprintf("&%s%s", source, fromField.Name)
+	valueAssignment := fmt.Sprintf("%s%s", source, fromField.Name)
 
 	if isNeedPointerCheckAndReturnError(fromField.Type, toField.Type, cf) {
 		conversion, err := getPointerCheck(
-			fmt.Sprintf("from.%s", fromField.Name),
+			fieldAccess(source, fromField),
 			toModel.Type.FullName(pkgPath),
 			getFieldPointerCheckError(
 				fromModel.Type.FullName(pkgPath),
@@ -152,7 +353,7 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 	switch getConversionRule(fromField.Type, toField.Type, cf) {
 	case NeedOnlyAssigmentRule:
 		pair.Assignment = getAssigmentBySameTypes(
-			fmt.Sprintf("from.%s", fromField.Name),
+			fieldAccess(source, fromField),
 			fromField.Type,
 			toField.Type,
 		)
@@ -165,7 +366,7 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 
 	case NeedCallConversionFunctionSeparatelyRule:
 		conversion, err := getPointerConversion(
-			fmt.Sprintf("from%s", fromField.Name),
+			fmt.Sprintf("%s%s", source, fromField.Name),
 			cfCall,
 		)
 		if err != nil {
@@ -192,8 +393,8 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 		}] = struct{}{}
 
 		conversion, err := getPointerToPointerConversion(
-			fmt.Sprintf("from%s", fromField.Name),
-			fmt.Sprintf("from.%s", fromField.Name),
+			fmt.Sprintf("%s%s", source, fromField.Name),
+			fieldAccess(source, fromField),
 			toModel.Type.FullName(pkgPath),
 			toField.Type.FullName(pkgPath),
 			cfCall,
@@ -228,7 +429,7 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 		}] = struct{}{}
 
 		conversion, err := getErrorConversion(
-			fmt.Sprintf("from%s", fromField.Name),
+			fmt.Sprintf("%s%s", source, fromField.Name),
 			toModel.Type.FullName(pkgPath),
 			cfCall,
 			errString,
@@ -245,7 +446,8 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 		}
 		return pair, pkgs, nil
 	case NeedRangeBySlice:
-		resPair, resPkgs, err := fillConversionFunctionBySlice(pair, fromField, toField, fromModel, toModel, cf, pkgPath)
+		resPair, resPkgs, err := fillConversionFunctionBySlice(pair, fromField, toField, fromModel, toModel, cf, pkgPath,
+			source)
 		if err != nil {
 			return FieldsPair{}, nil, err
 		}
@@ -265,7 +467,7 @@ func fillConversionFunction(pair FieldsPair, fromField, toField models.Field, fr
 }
 
 func fillConversionFunctionBySlice(pair FieldsPair, fromField, toField models.Field, fromModel, toModel models.Struct,
-	cf models.ConversionFunction, pkgPath string) (FieldsPair, models.Packages, error) {
+	cf models.ConversionFunction, pkgPath, source string) (FieldsPair, models.Packages, error) {
 
 	pkgs := make(models.Packages)
 
@@ -424,7 +626,9 @@ func fillConversionFunctionBySlice(pair FieldsPair, fromField, toField models.Fi
 	}
 
 	conversion, err := getSliceConversion(
+		source,
 		fromField.Name,
+		fieldAccess(source, fromField),
 		toField.Type.Additional.(models.SliceAdditional).InType.FullName(pkgPath),
 		assigment,
 		conversions,
@@ -438,3 +642,22 @@ func fillConversionFunctionBySlice(pair FieldsPair, fromField, toField models.Fi
 
 	return pair, pkgs, nil
 }
+
+// fieldAccess returns source field selector like from.Name or getter call like from.GetName()
+func fieldAccess(source string, field models.Field) string {
+	if field.Getter != "" {
+		return fmt.Sprintf("%s.%s()", source, field.Getter)
+	}
+
+	return fmt.Sprintf("%s.%s", source, field.Name)
+}
+
+func isReadByGetters(model models.Struct) bool {
+	for _, field := range model.Fields {
+		if field.Getter == "" {
+			return false
+		}
+	}
+
+	return len(model.Fields) != 0
+}
@@ -2,6 +2,7 @@ package loader
 
 import (
 	"embed"
+	"fmt"
 	"os"
 
 	"github.com/underbek/datamapper/models"
@@ -13,6 +14,9 @@ const (
 	embedFileName  = "data/converts.yaml"
 	fileNameByRoot = "loader/data/converts.yaml"
 
+	checkedEmbedFileName  = "data/checked.yaml"
+	checkedFileNameByRoot = "loader/data/checked.yaml"
+
 	defaultPerm = 0600
 )
 
@@ -20,17 +24,35 @@ const (
 var data embed.FS
 
 func Save(funcs models.Functions) error {
+	return save(markLossy(funcs), fileNameByRoot)
+}
+
+// SaveChecked saves overflow-checked conversion functions only for narrowing type pairs
+func SaveChecked(funcs models.Functions) error {
+	return save(filterLossy(funcs), checkedFileNameByRoot)
+}
+
+func Read() (models.Functions, error) {
+	return read(embedFileName)
+}
+
+// ReadChecked reads overflow-checked conversion functions which override functions by same keys
+func ReadChecked() (models.Functions, error) {
+	return read(checkedEmbedFileName)
+}
+
+func save(funcs models.Functions, fileName string) error {
 	funcSlice := values(funcs)
 	data, err := yaml.Marshal(funcSlice)
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(fileNameByRoot, data, defaultPerm)
+	return os.WriteFile(fileName, data, defaultPerm)
 }
 
-func Read() (models.Functions, error) {
-	body, err := data.ReadFile(embedFileName)
+func read(fileName string) (models.Functions, error) {
+	body, err := data.ReadFile(fileName)
 	if err != nil {
 		return nil, err
 	}
@@ -75,7 +97,8 @@ func values(m models.Functions) []models.ConversionFunction {
 			return false
 		}
 
-		return false
+		// collections and pointers have same names, compare full types for reproducible order
+		return fmt.Sprint(i.FromType, i.ToType) < fmt.Sprint(j.FromType, j.ToType)
 	})
 
 	return r
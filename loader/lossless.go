@@ -0,0 +1,110 @@
+package loader
+
+import "github.com/underbek/datamapper/models"
+
+type numericKind int
+
+const (
+	signedKind = numericKind(iota)
+	unsignedKind
+	floatKind
+)
+
+type numeric struct {
+	kind numericKind
+	bits int
+}
+
+// int, uint and uintptr are considered as 64 bits types
+var numerics = map[string]numeric{
+	"int":     {kind: signedKind, bits: 64},
+	"int8":    {kind: signedKind, bits: 8},
+	"int16":   {kind: signedKind, bits: 16},
+	"int32":   {kind: signedKind, bits: 32},
+	"int64":   {kind: signedKind, bits: 64},
+	"uint":    {kind: unsignedKind, bits: 64},
+	"uint8":   {kind: unsignedKind, bits: 8},
+	"uint16":  {kind: unsignedKind, bits: 16},
+	"uint32":  {kind: unsignedKind, bits: 32},
+	"uint64":  {kind: unsignedKind, bits: 64},
+	"uintptr": {kind: unsignedKind, bits: 64},
+	"float32": {kind: floatKind, bits: 24},
+	"float64": {kind: floatKind, bits: 53},
+}
+
+// isLossless returns true if every value of from type can be represented by to type.
+// Float bits are mantissa bits.
+func isLossless(from, to models.Type) bool {
+	if from.Kind != models.BaseType || to.Kind != models.BaseType {
+		return false
+	}
+
+	fromNumeric, ok := numerics[from.Name]
+	if !ok {
+		return false
+	}
+
+	toNumeric, ok := numerics[to.Name]
+	if !ok {
+		return false
+	}
+
+	switch {
+	case fromNumeric.kind == toNumeric.kind:
+		return fromNumeric.bits <= toNumeric.bits
+	case fromNumeric.kind == unsignedKind && toNumeric.kind == signedKind:
+		return fromNumeric.bits < toNumeric.bits
+	case fromNumeric.kind == signedKind && toNumeric.kind == floatKind:
+		return fromNumeric.bits <= toNumeric.bits+1
+	case fromNumeric.kind == unsignedKind && toNumeric.kind == floatKind:
+		return fromNumeric.bits <= toNumeric.bits
+	default:
+		return false
+	}
+}
+
+const decimalPackagePath = "github.com/shopspring/decimal"
+
+// isLossy returns true if conversion from type to type can lose data without error
+func isLossy(from, to models.Type, withError bool) bool {
+	if withError || to.Kind != models.BaseType {
+		return false
+	}
+
+	if _, ok := numerics[to.Name]; !ok {
+		return false
+	}
+
+	if from.Package.Path == decimalPackagePath {
+		return true
+	}
+
+	if _, ok := numerics[from.Name]; !ok || from.Kind != models.BaseType {
+		return false
+	}
+
+	return !isLossless(from, to)
+}
+
+// markLossy marks conversion functions which can lose data
+func markLossy(funcs models.Functions) models.Functions {
+	for key, cf := range funcs {
+		cf.Lossy = cf.Lossy || isLossy(key.FromType, key.ToType, cf.WithError)
+		funcs[key] = cf
+	}
+
+	return funcs
+}
+
+func filterLossy(funcs models.Functions) models.Functions {
+	res := make(models.Functions)
+	for key, cf := range funcs {
+		if isLossless(key.FromType, key.ToType) {
+			continue
+		}
+
+		res[key] = cf
+	}
+
+	return res
+}
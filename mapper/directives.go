@@ -0,0 +1,72 @@
+package mapper
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/options"
+	"github.com/underbek/datamapper/parser"
+)
+
+const convertorFileSuffix = "_convertor.go"
+
+// scanOptions creates options by conversions declared by comments of models from sources
+func scanOptions(lg logger.Logger, sources []string) ([]options.Option, error) {
+	var res []options.Option
+	for _, source := range sources {
+		directives, err := parser.ParseConvertDirectivesByPackage(lg, source)
+		if err != nil {
+			return nil, fmt.Errorf("parse convert directives error: %w", err)
+		}
+
+		for _, directive := range directives {
+			res = append(res, directiveOption(directive))
+		}
+	}
+
+	return res, nil
+}
+
+func directiveOption(directive models.ConvertDirective) options.Option {
+	model := options.Model{
+		Name:   directive.Model.Name,
+		Tag:    directive.ModelTag,
+		Source: directive.Dir,
+	}
+
+	otherSource := directive.Dir
+	if directive.OtherSource != "" {
+		otherSource = directive.OtherSource
+		if strings.HasPrefix(otherSource, ".") {
+			otherSource = filepath.Join(directive.Dir, otherSource)
+		}
+	}
+
+	other := options.Model{
+		Name:   directive.Other,
+		Tag:    directive.OtherTag,
+		Source: otherSource,
+	}
+
+	destination := directive.Destination
+	if destination == "" {
+		otherName, _ := parseModelName(directive.Other)
+		destination = strings.ToLower(directive.Model.Name+"_"+otherName) + convertorFileSuffix
+	}
+
+	opt := options.Option{
+		From:        model,
+		To:          other,
+		Inverse:     directive.To && directive.From,
+		Destination: filepath.Join(directive.Dir, destination),
+	}
+
+	if !directive.To {
+		opt.From, opt.To = other, model
+	}
+
+	return opt
+}
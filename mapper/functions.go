@@ -0,0 +1,232 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+var ErrAmbiguousFunction = errors.New("ambiguous conversion functions error")
+
+// functionsRegistry collects conversion functions and resolves conflicts of functions with the same types.
+// Preferred function wins, then function with higher priority, then the last added function
+type functionsRegistry struct {
+	lg        logger.Logger
+	funcs     models.Functions
+	preferred []string
+	// candidates are added functions by types, the first candidate is a function of funcs if it exists
+	candidates map[models.ConversionFunctionKey][]models.ConversionFunction
+	// reported are already logged conflicts of functions pairs
+	reported map[string]struct{}
+}
+
+func newFunctionsRegistry(lg logger.Logger, funcs models.Functions, preferred []string) *functionsRegistry {
+	return &functionsRegistry{
+		lg:         lg,
+		funcs:      funcs,
+		preferred:  preferred,
+		candidates: make(map[models.ConversionFunctionKey][]models.ConversionFunction),
+		reported:   make(map[string]struct{}),
+	}
+}
+
+// add adds candidate function by types. Functions are set to funcs by resolve
+func (r *functionsRegistry) add(key models.ConversionFunctionKey, function models.ConversionFunction) {
+	candidates, ok := r.candidates[key]
+	if !ok {
+		if prev, ok := r.funcs[key]; ok {
+			candidates = append(candidates, prev)
+		}
+	}
+
+	for i, candidate := range candidates {
+		if isSameFunction(candidate, function) {
+			candidates = append(candidates[:i], candidates[i+1:]...)
+			break
+		}
+	}
+
+	r.candidates[key] = append(candidates, function)
+}
+
+// resolve sets one function of candidates to funcs for each types pair
+func (r *functionsRegistry) resolve() error {
+	keys := make([]models.ConversionFunctionKey, 0, len(r.candidates))
+	for key := range r.candidates {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].FromType.FullName("")+keys[i].ToType.FullName("") <
+			keys[j].FromType.FullName("")+keys[j].ToType.FullName("")
+	})
+
+	for _, key := range keys {
+		function, err := r.resolveKey(key, r.candidates[key])
+		if err != nil {
+			return err
+		}
+
+		r.funcs[key] = function
+	}
+
+	r.candidates = make(map[models.ConversionFunctionKey][]models.ConversionFunction)
+
+	return nil
+}
+
+func (r *functionsRegistry) resolveKey(key models.ConversionFunctionKey, candidates []models.ConversionFunction) (
+	models.ConversionFunction, error) {
+
+	var preferred []models.ConversionFunction
+	for _, candidate := range candidates {
+		if r.isPreferred(candidate) {
+			preferred = append(preferred, candidate)
+		}
+	}
+
+	switch len(preferred) {
+	case 0:
+	case 1:
+		for _, candidate := range candidates {
+			if !isSameFunction(candidate, preferred[0]) {
+				r.report(candidate, preferred[0], "preferred function")
+			}
+		}
+
+		return preferred[0], nil
+	default:
+		return models.ConversionFunction{}, fmt.Errorf(
+			"%w: %s and %s are preferred for %s -> %s",
+			ErrAmbiguousFunction,
+			functionFullName(preferred[0]),
+			functionFullName(preferred[1]),
+			key.FromType.FullName(""),
+			key.ToType.FullName(""),
+		)
+	}
+
+	used := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if candidate.Priority >= used.Priority {
+			used = candidate
+		}
+	}
+
+	for _, candidate := range candidates {
+		switch {
+		case isSameFunction(candidate, used):
+		case candidate.Priority != used.Priority:
+			r.report(candidate, used, "higher priority")
+		default:
+			r.report(candidate, used, "")
+		}
+	}
+
+	return used, nil
+}
+
+// report logs conflict of not used function once. Conflicts without explicit rules are logged as warnings
+func (r *functionsRegistry) report(function, used models.ConversionFunction, reason string) {
+	name := functionFullName(function) + " " + functionFullName(used)
+	if _, ok := r.reported[name]; ok {
+		return
+	}
+	r.reported[name] = struct{}{}
+
+	if reason == "" {
+		r.lg.Warn(fmt.Sprintf(
+			"conversion functions %s and %s have the same types, the last %s is used",
+			functionFullName(function),
+			functionFullName(used),
+			functionFullName(used),
+		))
+
+		return
+	}
+
+	r.lg.Infof(
+		"conversion functions %s and %s have the same types, %s is used by %s",
+		functionFullName(function),
+		functionFullName(used),
+		functionFullName(used),
+		reason,
+	)
+}
+
+// isPreferred checks function by preferred names like {package}.{function}. Package can be a name or a path
+func (r *functionsRegistry) isPreferred(function models.ConversionFunction) bool {
+	for _, preferred := range r.preferred {
+		pkg, name := "", preferred
+		if i := strings.LastIndex(preferred, "."); i != -1 {
+			pkg, name = preferred[:i], preferred[i+1:]
+		}
+
+		if name != function.Name {
+			continue
+		}
+
+		if pkg == "" || pkg == function.Package.Path || pkg == function.Package.Name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkInverseFunctions warns about not used and lossy inverse conversion functions set by directives
+func checkInverseFunctions(lg logger.Logger, funcs models.Functions) {
+	warnings := make(map[string]struct{})
+	for key, function := range funcs {
+		if function.Inverse == "" {
+			continue
+		}
+
+		inverse, ok := funcs[models.ConversionFunctionKey{FromType: key.ToType, ToType: key.FromType}]
+		if !ok || inverse.Name != function.Inverse || inverse.Package.Path != function.Package.Path {
+			warnings[fmt.Sprintf(
+				"inverse function %s.%s of %s is not used for %s -> %s",
+				function.Package.Path,
+				function.Inverse,
+				functionFullName(function),
+				key.ToType.FullName(""),
+				key.FromType.FullName(""),
+			)] = struct{}{}
+
+			continue
+		}
+
+		if function.Lossy || inverse.Lossy {
+			names := []string{functionFullName(function), functionFullName(inverse)}
+			sort.Strings(names)
+
+			warnings[fmt.Sprintf(
+				"inverse functions %s and %s are lossy, round trip conversion can lose data",
+				names[0],
+				names[1],
+			)] = struct{}{}
+		}
+	}
+
+	messages := make([]string, 0, len(warnings))
+	for message := range warnings {
+		messages = append(messages, message)
+	}
+	sort.Strings(messages)
+
+	for _, message := range messages {
+		lg.Warn(message)
+	}
+}
+
+func isSameFunction(a, b models.ConversionFunction) bool {
+	return a.Name == b.Name && a.Package.Path == b.Package.Path && a.Receiver == b.Receiver
+}
+
+func functionFullName(function models.ConversionFunction) string {
+	return fmt.Sprintf("%s.%s", function.Package.Path, function.Name)
+}
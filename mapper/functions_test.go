@@ -0,0 +1,106 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_FunctionsRegistry(t *testing.T) {
+	key := models.ConversionFunctionKey{
+		FromType: models.Type{Name: "string"},
+		ToType:   models.Type{Name: "int"},
+	}
+
+	function := func(pkg string, priority int) models.ConversionFunction {
+		return models.ConversionFunction{
+			Name:     "ConvertStringToInt",
+			Package:  models.Package{Name: pkg, Path: "github.com/user/" + pkg},
+			FromType: key.FromType,
+			ToType:   key.ToType,
+			Priority: priority,
+		}
+	}
+
+	method := func(pkg string, priority int) models.ConversionFunction {
+		res := function(pkg, priority)
+		res.Receiver = "c." + pkg
+		return res
+	}
+
+	tests := []struct {
+		name      string
+		functions []models.ConversionFunction
+		preferred []string
+		expected  models.ConversionFunction
+		err       error
+	}{
+		{
+			name:      "Last function",
+			functions: []models.ConversionFunction{function("first", 0), function("second", 0)},
+			expected:  function("second", 0),
+		},
+		{
+			name:      "Higher priority",
+			functions: []models.ConversionFunction{function("first", 1), function("second", 0)},
+			expected:  function("first", 1),
+		},
+		{
+			name:      "Preferred by package name",
+			functions: []models.ConversionFunction{function("first", 0), function("second", 1)},
+			preferred: []string{"first.ConvertStringToInt"},
+			expected:  function("first", 0),
+		},
+		{
+			name:      "Preferred by package path",
+			functions: []models.ConversionFunction{function("first", 0), function("second", 0)},
+			preferred: []string{"github.com/user/first.ConvertStringToInt"},
+			expected:  function("first", 0),
+		},
+		{
+			name:      "Method with lower priority",
+			functions: []models.ConversionFunction{function("first", 1), method("second", 0)},
+			expected:  function("first", 1),
+		},
+		{
+			name:      "Function after method",
+			functions: []models.ConversionFunction{method("first", 0), function("second", 0)},
+			expected:  function("second", 0),
+		},
+		{
+			name:      "Preferred method",
+			functions: []models.ConversionFunction{method("first", 0), function("second", 1)},
+			preferred: []string{"first.ConvertStringToInt"},
+			expected:  method("first", 0),
+		},
+		{
+			name:      "Some preferred functions",
+			functions: []models.ConversionFunction{function("first", 0), function("second", 0)},
+			preferred: []string{"ConvertStringToInt"},
+			err:       ErrAmbiguousFunction,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := newFunctionsRegistry(logger.New(), make(models.Functions), tt.preferred)
+
+			for _, function := range tt.functions {
+				registry.add(key, function)
+			}
+
+			err := registry.resolve()
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, registry.funcs[key])
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package mapper
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/parser"
+	"golang.org/x/exp/maps"
+)
+
+// withInterfaceModels returns source structs with interface models with getters of source package
+func withInterfaceModels(lg logger.Logger, source string, structs map[string]models.Struct) (
+	map[string]models.Struct, error) {
+
+	interfaces, err := parser.ParseInterfaceModelsByPackage(lg, source)
+	if err != nil {
+		return nil, fmt.Errorf("parse interface models error: %w", err)
+	}
+
+	res := maps.Clone(structs)
+	maps.Copy(res, interfaces)
+
+	return res, nil
+}
+
+// withGetters sets getters of model fields, so fields are read by getters like GetName().
+// Fields of interface models are tagged by snake case names like user_id for GetUserID()
+func withGetters(model models.Struct, tag string) models.Struct {
+	fields := make([]models.Field, 0, len(model.Fields))
+	for _, field := range model.Fields {
+		if getter, ok := model.Getters[field.Name]; ok {
+			field.Getter = getter
+		}
+
+		if model.Type.Kind == models.InterfaceType {
+			field.Tags = append([]models.Tag{{Name: tag, Value: snakeCase(field.Name)}}, field.Tags...)
+		}
+
+		fields = append(fields, field)
+	}
+
+	model.Fields = fields
+
+	return model
+}
+
+// snakeCase converts name like UserID or HTTPServer to user_id or http_server
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var sb strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 {
+			prevLower := unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower && unicode.IsUpper(runes[i-1]) {
+				sb.WriteRune('_')
+			}
+		}
+
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,19 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"Name":       "name",
+		"UserID":     "user_id",
+		"HTTPServer": "http_server",
+		"Address2":   "address2",
+		"FullName":   "full_name",
+	} {
+		assert.Equal(t, expected, snakeCase(name))
+	}
+}
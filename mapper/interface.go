@@ -0,0 +1,47 @@
+package mapper
+
+import "github.com/underbek/datamapper/models"
+
+// mapperInterface collects all generated convertors of destination for generated mapper interface
+type mapperInterface struct {
+	name        string
+	destination string
+	functions   []models.ConversionFunction
+}
+
+func newMapperInterface(name, destination string) *mapperInterface {
+	if name == "" {
+		return nil
+	}
+
+	return &mapperInterface{
+		name:        name,
+		destination: destination,
+	}
+}
+
+func (m *mapperInterface) add(functions []models.ConversionFunction) {
+	m.functions = append(m.functions, functions...)
+}
+
+// convertors returns root convertors and then other collected convertors.
+// Convertors generated again with other signatures are replaced by the last generated ones
+func (m *mapperInterface) convertors(root []models.ConversionFunction) []models.ConversionFunction {
+	last := make(map[string]models.ConversionFunction, len(m.functions))
+	for _, function := range m.functions {
+		last[function.Name] = function
+	}
+
+	res := make([]models.ConversionFunction, 0, len(last))
+	added := make(map[string]struct{}, len(last))
+	for _, function := range append(root, m.functions...) {
+		if _, ok := added[function.Name]; ok {
+			continue
+		}
+
+		added[function.Name] = struct{}{}
+		res = append(res, last[function.Name])
+	}
+
+	return res
+}
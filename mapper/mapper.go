@@ -17,57 +17,178 @@ import (
 	"golang.org/x/exp/maps"
 )
 
+// convertsPackagePath is a package of built-in conversion functions
+const convertsPackagePath = "github.com/underbek/datamapper/converts"
+
 var (
 	ErrNotFoundStruct = errors.New("not found struct error")
 	ErrNotFoundTag    = errors.New("not found tag error")
+	ErrNotFoundFunc   = errors.New("not found computed function error")
+	ErrMergeOption    = errors.New("unsupported option for merged models error")
 )
 
+// conversionFunctions are conversion functions of cf option or conversion methods of its dependency struct
+type conversionFunctions struct {
+	dependency *models.Type
+	functions  models.Functions
+	priority   int
+}
+
 func MapModels(lg logger.Logger, opts options.Options) error {
 	funcs, err := loader.Read()
 	if err != nil {
 		return fmt.Errorf("parse internal conversion functions error: %w", err)
 	}
 
+	checkedFuncs, err := loader.ReadChecked()
+	if err != nil {
+		return fmt.Errorf("parse internal checked conversion functions error: %w", err)
+	}
+
 	cfAliases := map[string]string{}
+	var dependencies []models.Type
+	var cfs []conversionFunctions
+	hooks := make(models.Hooks)
+	computedFuncs := make(models.ComputedFunctions)
+	registry := newFunctionsRegistry(lg, funcs, opts.PreferredFunctions)
 
 	if len(opts.ConversionFunctions) != 0 {
 		for _, cf := range opts.ConversionFunctions {
+			if cf.Struct != "" {
+				dependency, res, err := parser.ParseConversionMethodsByPackage(lg, cf.Source, cf.Struct)
+				if err != nil {
+					return fmt.Errorf("parse user conversion methods error: %w", err)
+				}
+
+				cfAliases[dependency.Package.Path] = cf.Alias
+				dependencies = append(dependencies, dependency)
+				cfs = append(cfs, conversionFunctions{
+					dependency: &dependency,
+					functions:  res,
+					priority:   cf.Priority,
+				})
+
+				continue
+			}
+
 			res, err := parser.ParseConversionFunctionsByPackage(lg, cf.Source)
 			if err != nil {
 				return fmt.Errorf("parse user conversion functions error: %w", err)
 			}
 
-			for key, function := range res {
+			for _, function := range res {
+				cfAliases[function.Package.Path] = cf.Alias
+			}
+
+			cfs = append(cfs, conversionFunctions{
+				functions: res,
+				priority:  cf.Priority,
+			})
+
+			cfHooks, err := parser.ParseHooksByPackage(lg, cf.Source)
+			if err != nil {
+				return fmt.Errorf("parse user hooks error: %w", err)
+			}
+
+			for name, hook := range cfHooks {
+				cfAliases[hook.Package.Path] = cf.Alias
+				hooks[name] = hook
+			}
+
+			cfComputedFuncs, err := parser.ParseComputedFunctionsByPackage(lg, cf.Source)
+			if err != nil {
+				return fmt.Errorf("parse user computed functions error: %w", err)
+			}
+
+			for name, function := range cfComputedFuncs {
 				cfAliases[function.Package.Path] = cf.Alias
-				funcs[key] = function
+				computedFuncs[name] = function
+			}
+		}
+	}
+
+	// functions are added after parsing of all options, because receivers of conversion methods
+	// are dependency fields with unique names. Functions and methods are added in order of options
+	for _, cf := range cfs {
+		for key, function := range cf.functions {
+			if cf.dependency != nil {
+				function.Receiver = generator.DependencyReceiver(*cf.dependency, dependencies)
 			}
+			if function.Priority == 0 {
+				function.Priority = cf.priority
+			}
+			registry.add(key, function)
 		}
 	}
 
-	for _, opt := range opts.Options {
+	err = registry.resolve()
+	if err != nil {
+		return err
+	}
+
+	checkInverseFunctions(lg, funcs)
+
+	scanned, err := scanOptions(lg, opts.Scan)
+	if err != nil {
+		return err
+	}
+
+	expanded, err := expandOptions(lg, append(opts.Options, scanned...))
+	if err != nil {
+		return err
+	}
+
+	for _, opt := range expanded {
+		if opt.Clone {
+			err = mapClone(lg, opt)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		optFuncs := funcs
+		if opt.Checked {
+			optFuncs = withCheckedFunctions(funcs, checkedFuncs)
+		}
+
+		if isMapModelName(opt.From.Name) || isMapModelName(opt.To.Name) {
+			err = mapStructAndMap(lg, opt, cfAliases, dependencies, optFuncs)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		fromStructs, err := parser.ParseModelsByPackage(lg, opt.From.Source)
 		if err != nil {
 			return fmt.Errorf("parse models error: %w", err)
 		}
 
-		fromName, isFromPointer := parseModelName(opt.From.Name)
-		from, ok := fromStructs[fromName]
+		// interfaces with getters are source models only if fields are read by getters
+		if opt.Getters {
+			fromStructs, err = withInterfaceModels(lg, opt.From.Source, fromStructs)
+			if err != nil {
+				return err
+			}
+		}
+
+		from, ok := lookupModel(fromStructs, opt.From.Name)
 		if !ok {
 			return fmt.Errorf(" %w: source model %s from %s", ErrNotFoundStruct, opt.From.Name, opt.From.Source)
 		}
-		from.Type.Pointer = isFromPointer
 
 		toStructs, err := parser.ParseModelsByPackage(lg, opt.To.Source)
 		if err != nil {
 			return fmt.Errorf("parse models error: %w", err)
 		}
 
-		toName, isToPointer := parseModelName(opt.To.Name)
-		to, ok := toStructs[toName]
+		to, ok := lookupModel(toStructs, opt.To.Name)
 		if !ok {
 			return fmt.Errorf("%w: to model %s from %s", ErrNotFoundStruct, opt.To.Name, opt.To.Source)
 		}
-		to.Type.Pointer = isToPointer
 
 		aliases := map[string]string{
 			from.Type.Package.Path: opt.From.Alias,
@@ -76,44 +197,140 @@ func MapModels(lg logger.Logger, opts options.Options) error {
 
 		maps.Copy(aliases, cfAliases)
 
-		funcs, err = mapModel(
-			lg,
-			from,
-			to,
-			opt.From.Tag,
-			opt.To.Tag,
-			opt.Destination,
-			opt.Inverse,
-			opt.Recursive,
-			opt.WithPointers,
-			aliases,
-			funcs,
-			fromStructs,
-			toStructs,
-		)
+		if opt.Convertor != "" {
+			err = mapConvertorStruct(lg, opt.Convertor, opt.Destination, dependencies, aliases)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(opt.Merge) != 0 {
+			err = mapMergedModels(
+				lg,
+				opt,
+				from,
+				to,
+				generator.ConvertorOptions{
+					Receiver: opt.Convertor,
+					Validate: opt.Validate,
+				},
+				computedFuncs,
+				aliases,
+				optFuncs,
+			)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		optFuncs, err = mapModel(lg, modelMapping{
+			from:         from,
+			to:           to,
+			fromTag:      opt.From.Tag,
+			toTag:        opt.To.Tag,
+			destination:  opt.Destination,
+			inverse:      opt.Inverse,
+			recursive:    opt.Recursive,
+			withPointers: opt.WithPointers,
+			getters:      opt.Getters,
+			maxChain:     opt.MaxChain,
+			convertorOpts: generator.ConvertorOptions{
+				Receiver:          opt.Convertor,
+				Hooks:             hooks,
+				BeforeHook:        opt.BeforeHook,
+				AfterHook:         opt.AfterHook,
+				Validate:          opt.Validate,
+				Construct:         opt.Construct,
+				Constructor:       opt.Constructor,
+				ConstructorParams: opt.ConstructorParams,
+			},
+			mapper:        newMapperInterface(opt.Mapper, opt.Destination),
+			computed:      opt.Computed,
+			computedFuncs: computedFuncs,
+			aliases:       aliases,
+			fromStructs:   fromStructs,
+			toStructs:     toStructs,
+		}, optFuncs)
 		if err != nil {
 			return err
 		}
+
+		if opt.Checked {
+			optFuncs = withoutCheckedFunctions(optFuncs, funcs, checkedFuncs)
+		}
+
+		funcs = optFuncs
 	}
 
 	return nil
 }
 
+// withCheckedFunctions overrides built-in unchecked conversion functions by checked functions with same keys.
+// User conversion functions are kept
+func withCheckedFunctions(funcs, checkedFuncs models.Functions) models.Functions {
+	res := make(models.Functions, len(funcs))
+	for key, cf := range funcs {
+		res[key] = cf
+	}
+
+	for key, cf := range checkedFuncs {
+		if prev, ok := res[key]; ok && prev.Package.Path != convertsPackagePath {
+			continue
+		}
+
+		res[key] = cf
+	}
+
+	return res
+}
+
+// withoutCheckedFunctions restores unchecked conversion functions so that next options are not affected
+func withoutCheckedFunctions(funcs, uncheckedFuncs, checkedFuncs models.Functions) models.Functions {
+	for key := range checkedFuncs {
+		cf, ok := uncheckedFuncs[key]
+		if !ok {
+			delete(funcs, key)
+			continue
+		}
+
+		funcs[key] = cf
+	}
+
+	return funcs
+}
+
 func setPackageAlias(p *models.Package, aliases map[string]string) {
 	p.Alias = aliases[p.Path]
 }
 
 func setPackageAliasToStruct(m *models.Struct, aliases map[string]string) {
-	setPackageAlias(&m.Type.Package, aliases)
+	m.Type = setPackageAliasToType(m.Type, aliases)
 	for i := range m.Fields {
-		setPackageAlias(&m.Fields[i].Type.Package, aliases)
-		switch m.Fields[i].Type.Kind {
-		case models.SliceType:
-			additional := m.Fields[i].Type.Additional.(models.SliceAdditional)
-			setPackageAlias(&additional.InType.Package, aliases)
-			m.Fields[i].Type.Additional = additional
-		}
+		m.Fields[i].Type = setPackageAliasToType(m.Fields[i].Type, aliases)
+	}
+}
+
+func setPackageAliasToType(t models.Type, aliases map[string]string) models.Type {
+	setPackageAlias(&t.Package, aliases)
+	switch additional := t.Additional.(type) {
+	case models.SliceAdditional:
+		additional.InType = setPackageAliasToType(additional.InType, aliases)
+		t.Additional = additional
+	case models.ArrayAdditional:
+		additional.InType = setPackageAliasToType(additional.InType, aliases)
+		t.Additional = additional
+	case models.MapAdditional:
+		additional.KeyType = setPackageAliasToType(additional.KeyType, aliases)
+		additional.ValueType = setPackageAliasToType(additional.ValueType, aliases)
+		t.Additional = additional
+	case models.GenericAdditional:
+		additional.TypeArg = setPackageAliasToType(additional.TypeArg, aliases)
+		t.Additional = additional
 	}
+
+	return t
 }
 
 func setPackageAliasToCfKey(key models.ConversionFunctionKey, aliases map[string]string) models.ConversionFunctionKey {
@@ -131,6 +348,15 @@ func setPackageAliasToCf(cf models.ConversionFunction, aliases map[string]string
 	return cf
 }
 
+func setPackageAliasToHooks(hooks models.Hooks, aliases map[string]string) models.Hooks {
+	res := make(models.Hooks, len(hooks))
+	for name, hook := range hooks {
+		setPackageAlias(&hook.Package, aliases)
+		res[name] = hook
+	}
+	return res
+}
+
 func setPackageAliasToFunctions(funcs models.Functions, aliases map[string]string) models.Functions {
 	res := make(models.Functions)
 	for key, cf := range funcs {
@@ -139,6 +365,10 @@ func setPackageAliasToFunctions(funcs models.Functions, aliases map[string]strin
 	return res
 }
 
+func isMapModelName(modelName string) bool {
+	return modelName == generator.MapModelName || modelName == "map[string]interface{}"
+}
+
 func parseModelName(modelName string) (string, bool) {
 	if strings.HasPrefix(modelName, "*") {
 		return strings.TrimPrefix(modelName, "*"), true
@@ -147,61 +377,156 @@ func parseModelName(modelName string) (string, bool) {
 	return modelName, false
 }
 
-func mapModel(
-	lg logger.Logger,
-	from, to models.Struct,
-	fromTag, toTag string,
-	destination string,
-	inverse bool,
-	recursive bool,
-	withPointers bool,
-	aliases map[string]string,
-	funcs models.Functions,
-	fromStructs, toStructs map[string]models.Struct,
-) (models.Functions, error) {
+// lookupModel finds model by name like *User or generic model instantiation like Page[User],
+// type argument of generic model is a model of the same package
+func lookupModel(structs map[string]models.Struct, modelName string) (models.Struct, bool) {
+	name, isPointer := parseModelName(modelName)
+	name, argName, isGeneric := parseGenericModelName(name)
 
-	from.Fields = utils.FilterFields(fromTag, from.Fields)
+	model, ok := structs[name]
+	if !ok || isGeneric != (len(model.TypeParams) != 0) {
+		return models.Struct{}, false
+	}
+
+	if isGeneric {
+		argName, isArgPointer := parseModelName(argName)
+		arg, ok := structs[argName]
+		if !ok {
+			return models.Struct{}, false
+		}
+		arg.Type.Pointer = isArgPointer
+
+		genericType := model.Type
+		genericType.Additional = models.GenericAdditional{TypeArg: arg.Type}
+
+		var err error
+		model, err = generator.InstantiateModel(model, genericType)
+		if err != nil {
+			return models.Struct{}, false
+		}
+	}
+
+	model.Type.Pointer = isPointer
+
+	return model, true
+}
+
+// parseGenericModelName parses generic model name like Page[User]
+func parseGenericModelName(modelName string) (string, string, bool) {
+	name, arg, ok := strings.Cut(modelName, "[")
+	if !ok || !strings.HasSuffix(arg, "]") {
+		return modelName, "", false
+	}
+
+	return name, strings.TrimSuffix(arg, "]"), true
+}
+
+// modelMapping is a mapping of source model to target model.
+// Nested models are mapped by copy of mapping with nested models, destination and convertor options
+type modelMapping struct {
+	from, to       models.Struct
+	fromTag, toTag string
+	destination    string
+	inverse        bool
+	recursive      bool
+	withPointers   bool
+	getters        bool
+	maxChain       int
+	convertorOpts  generator.ConvertorOptions
+	mapper         *mapperInterface
+	computed       []options.ComputedField
+	computedFuncs  models.ComputedFunctions
+	aliases        map[string]string
+	fromStructs    map[string]models.Struct
+	toStructs      map[string]models.Struct
+}
+
+// mapModel generates convertors of mapping models and nested models. Mapping is kept to generate convertors again
+// if they were called by declarations with other signatures
+func mapModel(lg logger.Logger, m modelMapping, funcs models.Functions) (models.Functions, error) {
+	entry := m
+	from, to, convertorOpts := m.from, m.to, m.convertorOpts
+
+	if m.getters {
+		from = withGetters(from, m.fromTag)
+		to = withGetters(to, m.toTag)
+	}
+
+	from.Fields = utils.FilterFields(m.fromTag, from.Fields)
 	if len(from.Fields) == 0 {
 		return nil, fmt.Errorf(
 			"%w: source model %s does not contain tag %s",
 			ErrNotFoundTag,
 			from.Type.Name,
-			fromTag,
+			m.fromTag,
 		)
 	}
 
-	to.Fields = utils.FilterFields(toTag, to.Fields)
+	to.Fields = utils.FilterFields(m.toTag, to.Fields)
 	if len(to.Fields) == 0 {
 		return nil, fmt.Errorf(
 			"%w: to model %s does not contain tag %s",
 			ErrNotFoundTag,
 			to.Type.Name,
-			toTag,
+			m.toTag,
 		)
 	}
 
 	// set aliases
-	setPackageAliasToStruct(&from, aliases)
-	setPackageAliasToStruct(&to, aliases)
+	setPackageAliasToStruct(&from, m.aliases)
+	setPackageAliasToStruct(&to, m.aliases)
 
-	err := os.MkdirAll(path.Dir(destination), os.ModePerm)
+	err := os.MkdirAll(path.Dir(m.destination), os.ModePerm)
 	if err != nil {
-		return nil, fmt.Errorf("create destination dir %s error: %w", path.Dir(destination), err)
+		return nil, fmt.Errorf("create destination dir %s error: %w", path.Dir(m.destination), err)
 	}
 
-	pkg, err := parser.ParseDestinationPackage(lg, destination)
+	pkg, err := parser.ParseDestinationPackage(lg, m.destination)
+	if err != nil {
+		return nil, fmt.Errorf("parse destination package %s error: %w", m.destination, err)
+	}
+
+	// hooks from destination package override hooks from conversion functions packages
+	destinationHooks, err := parser.ParseHooks(lg, utils.ClearFileName(m.destination))
+	if err != nil {
+		return nil, fmt.Errorf("parse destination hooks %s error: %w", m.destination, err)
+	}
+
+	hooks := setPackageAliasToHooks(convertorOpts.Hooks, m.aliases)
+	for name, hook := range destinationHooks {
+		hooks[name] = hook
+	}
+	convertorOpts.Hooks = hooks
+
+	convertorOpts.Computed, err = getComputedFields(lg, m.computed, m.computedFuncs, m.destination, m.aliases)
 	if err != nil {
-		return nil, fmt.Errorf("parse destination package %s error: %w", destination, err)
+		return nil, err
+	}
+
+	// explicit hooks are used only by direct convertor
+	nestedOpts := generator.ConvertorOptions{
+		Receiver:  convertorOpts.Receiver,
+		Hooks:     convertorOpts.Hooks,
+		Validate:  convertorOpts.Validate,
+		Construct: convertorOpts.Construct,
 	}
 
+	// convertors being generated are declared before generation,
+	// so cyclic models call them instead of recursive mapping
+	entryFuncs := funcs
+	funcs = cloneFunctions(funcs)
+	declarations := declareConvertors(funcs, from, to, pkg, convertorOpts.Receiver, m.inverse)
+
 	var convertors []string
+	var generated, chains []models.ConversionFunction
 	pkgs := make(models.Packages)
 	for {
-		funcs = setPackageAliasToFunctions(funcs, aliases)
+		funcs = setPackageAliasToFunctions(funcs, m.aliases)
 		var gcf models.GeneratedConversionFunction
-		gcf, err = generator.GenerateConvertor(from, to, pkg, funcs)
+		gcf, err = generator.GenerateConvertorWithOptions(from, to, pkg, funcs, convertorOpts)
 		if err == nil {
 			convertors = append(convertors, gcf.Body)
+			generated = append(generated, gcf.Function)
 			funcs[models.ConversionFunctionKey{
 				FromType: gcf.Function.FromType,
 				ToType:   gcf.Function.ToType,
@@ -210,73 +535,834 @@ func mapModel(
 			break
 		}
 
-		if !recursive {
-			return nil, err
-		}
-
 		var findError *generator.FindFieldsPairError
 		if !errors.As(err, &findError) {
 			return nil, err
 		}
 
-		if findError.From.Package != from.Type.Package || findError.To.Package != to.Type.Package {
-			return nil, err
+		chain, ok, chainErr := mapConversionChain(lg, findError, pkg, funcs, m.maxChain, m.destination)
+		if chainErr != nil {
+			return nil, chainErr
 		}
 
-		fromField, fromOk := fromStructs[findError.From.Name]
-		toField, toOk := toStructs[findError.To.Name]
+		if ok {
+			convertors = append(convertors, chain.Body)
+			chains = append(chains, chain.Function)
+			maps.Copy(pkgs, chain.Packages)
+			continue
+		}
+
+		// nested models of other packages are parsed from their own packages by recursive mapping
+		nestedFromStructs, nestedErr := nestedStructs(lg, findError.From, from.Type.Package, m.fromStructs, m.recursive,
+			m.getters)
+		if nestedErr != nil {
+			return nil, nestedErr
+		}
+
+		nestedToStructs, nestedErr := nestedStructs(lg, findError.To, to.Type.Package, m.toStructs, m.recursive, false)
+		if nestedErr != nil {
+			return nil, nestedErr
+		}
+
+		fromField, fromOk := nestedModel(nestedFromStructs, findError.From)
+		toField, toOk := nestedModel(nestedToStructs, findError.To)
 
 		if !fromOk || !toOk {
 			return nil, err
 		}
 
-		if withPointers {
+		addPackageAlias(m.aliases, fromField.Type.Package)
+		addPackageAlias(m.aliases, toField.Type.Package)
+		setPackageAliasToStruct(&from, m.aliases)
+		setPackageAliasToStruct(&to, m.aliases)
+
+		// nested models with the same layout are converted by type conversion without convertor
+		if isDirectConvertible(fromField, toField, m.fromTag, m.toTag) {
+			setPackageAliasToStruct(&fromField, m.aliases)
+			setPackageAliasToStruct(&toField, m.aliases)
+
+			funcs[models.ConversionFunctionKey{
+				FromType: fromField.Type,
+				ToType:   toField.Type,
+			}] = generator.DirectConversionFunction(fromField.Type, toField.Type)
+
+			if m.inverse {
+				funcs[models.ConversionFunctionKey{
+					FromType: toField.Type,
+					ToType:   fromField.Type,
+				}] = generator.DirectConversionFunction(toField.Type, fromField.Type)
+			}
+
+			continue
+		}
+
+		if !m.recursive {
+			return nil, err
+		}
+
+		if m.withPointers {
 			fromField.Type.Pointer = findError.From.Pointer
 			toField.Type.Pointer = findError.To.Pointer
 		}
 
-		funcs, err = mapModel(
-			lg,
-			fromField,
-			toField,
-			fromTag,
-			toTag,
-			generateDestination(fromField.Type.Name, destination),
-			inverse,
-			recursive,
-			withPointers,
-			aliases,
-			funcs,
-			fromStructs,
-			toStructs,
-		)
+		nested := m
+		nested.from, nested.to = fromField, toField
+		nested.destination = generateDestination(modelFileName(fromField.Type), m.destination)
+		nested.convertorOpts = nestedOpts
+		nested.computed, nested.computedFuncs = nil, nil
+		nested.fromStructs, nested.toStructs = nestedFromStructs, nestedToStructs
+
+		funcs, err = mapModel(lg, nested, funcs)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if inverse {
-		gcf, err := generator.GenerateConvertor(to, from, pkg, funcs)
+	// inverse convertor is generated again after each added conversion chain
+	for m.inverse {
+		gcf, err := generator.GenerateConvertorWithOptions(to, from, pkg, funcs, nestedOpts)
 		if err != nil {
-			return nil, fmt.Errorf("generate convertor error: %w", err)
+			var findError *generator.FindFieldsPairError
+			if !errors.As(err, &findError) {
+				return nil, fmt.Errorf("generate convertor error: %w", err)
+			}
+
+			chain, ok, chainErr := mapConversionChain(lg, findError, pkg, funcs, m.maxChain, m.destination)
+			if chainErr != nil {
+				return nil, chainErr
+			}
+
+			if !ok {
+				return nil, fmt.Errorf("generate convertor error: %w", err)
+			}
+
+			convertors = append(convertors, chain.Body)
+			chains = append(chains, chain.Function)
+			maps.Copy(pkgs, chain.Packages)
+			continue
 		}
 		convertors = append(convertors, gcf.Body)
+		generated = append(generated, gcf.Function)
 		funcs[models.ConversionFunctionKey{
 			FromType: gcf.Function.FromType,
 			ToType:   gcf.Function.ToType,
 		}] = gcf.Function
 		maps.Copy(pkgs, gcf.Packages)
+		break
 	}
 
-	err = generator.CreateConvertorSource(pkg, pkgs, convertors, destination)
+	// convertors are generated again if they were called by declarations with other signatures
+	if redeclared, ok := redeclareConvertors(entryFuncs, declarations, generated); ok {
+		return mapModel(lg, entry, redeclared)
+	}
+
+	// mapper interface is generated in root destination after all nested convertors are generated
+	if m.mapper != nil {
+		m.mapper.add(append(generated, chains...))
+		if m.mapper.destination == m.destination {
+			body, err := generator.GenerateMapper(m.mapper.name, convertorOpts.Receiver, pkg, m.mapper.convertors(generated))
+			if err != nil {
+				return nil, fmt.Errorf("generate mapper error: %w", err)
+			}
+			convertors = append(convertors, body)
+		}
+	}
+
+	err = generator.CreateConvertorSource(pkg, pkgs, convertors, m.destination)
 	if err != nil {
 		return nil, fmt.Errorf("create convertor source error: %w", err)
 	}
-	lg.Infof("generated convertor source: \"%s\"", destination)
+	lg.Infof("generated convertor source: \"%s\"", m.destination)
 
 	return funcs, nil
 }
 
+// mapConversionChain generates convertor by chain of conversion functions for not found fields types pair
+// and adds it to conversion functions
+func mapConversionChain(
+	lg logger.Logger,
+	findError *generator.FindFieldsPairError,
+	pkg models.Package,
+	funcs models.Functions,
+	maxChain int,
+	destination string,
+) (models.GeneratedConversionFunction, bool, error) {
+	if maxChain <= 0 {
+		return models.GeneratedConversionFunction{}, false, nil
+	}
+
+	chain, ok := generator.FindConversionChain(findError.From, findError.To, funcs, maxChain)
+	if !ok {
+		return models.GeneratedConversionFunction{}, false, nil
+	}
+
+	names, err := chainReservedNames(lg, pkg, funcs, destination)
+	if err != nil {
+		return models.GeneratedConversionFunction{}, false, err
+	}
+
+	gcf, err := generator.GenerateChainConvertor(chain, pkg, names)
+	if err != nil {
+		return models.GeneratedConversionFunction{}, false, fmt.Errorf("generate conversion chain error: %w", err)
+	}
+
+	lg.Infof("conversion chain %s: %s", gcf.Function.Name, generator.ChainDescription(chain))
+
+	funcs[models.ConversionFunctionKey{
+		FromType: gcf.Function.FromType,
+		ToType:   gcf.Function.ToType,
+	}] = gcf.Function
+
+	return gcf, true, nil
+}
+
+// chainReservedNames returns names of functions of destination package which can't be used by chain convertor.
+// Conversion functions of destination package and names declared in other files of destination package are reserved
+func chainReservedNames(lg logger.Logger, pkg models.Package, funcs models.Functions, destination string) (
+	map[string]struct{}, error) {
+
+	names, err := parser.ParseDestinationNames(lg, destination)
+	if err != nil {
+		return nil, fmt.Errorf("parse destination names %s error: %w", destination, err)
+	}
+
+	for _, cf := range funcs {
+		if cf.Package.Path == pkg.Path && cf.Receiver == "" {
+			names[cf.Name] = struct{}{}
+		}
+	}
+
+	return names, nil
+}
+
+func cloneFunctions(funcs models.Functions) models.Functions {
+	res := make(models.Functions, len(funcs))
+	for key, cf := range funcs {
+		res[key] = cf
+	}
+
+	return res
+}
+
+// declareConvertors adds declarations of convertor and its inverse convertor if they are not declared yet
+func declareConvertors(
+	funcs models.Functions,
+	from, to models.Struct,
+	pkg models.Package,
+	receiver string,
+	inverse bool,
+) map[models.ConversionFunctionKey]models.ConversionFunction {
+	declarations := make(map[models.ConversionFunctionKey]models.ConversionFunction)
+
+	declare := func(from, to models.Struct) {
+		key := models.ConversionFunctionKey{
+			FromType: from.Type,
+			ToType:   to.Type,
+		}
+
+		if _, ok := funcs[key]; ok {
+			return
+		}
+
+		declarations[key] = generator.ConvertorDeclaration(from, to, pkg, receiver)
+		funcs[key] = declarations[key]
+	}
+
+	declare(from, to)
+	if inverse {
+		declare(to, from)
+	}
+
+	return declarations
+}
+
+// redeclareConvertors returns entry functions with declarations fixed by generated convertors
+// if generated convertors signatures are different from declarations
+func redeclareConvertors(
+	entryFuncs models.Functions,
+	declarations map[models.ConversionFunctionKey]models.ConversionFunction,
+	generated []models.ConversionFunction,
+) (models.Functions, bool) {
+	changed := false
+	for _, function := range generated {
+		key := models.ConversionFunctionKey{
+			FromType: function.FromType,
+			ToType:   function.ToType,
+		}
+
+		declaration, ok := declarations[key]
+		if !ok || declaration.WithError == function.WithError && declaration.WithContext == function.WithContext {
+			continue
+		}
+
+		declaration.WithError = function.WithError
+		declaration.WithContext = function.WithContext
+		declarations[key] = declaration
+		changed = true
+	}
+
+	if !changed {
+		return nil, false
+	}
+
+	res := cloneFunctions(entryFuncs)
+	for key, declaration := range declarations {
+		res[key] = declaration
+	}
+
+	return res, true
+}
+
+// nestedModel finds nested model by type. Generic models are instantiated by type argument
+func nestedModel(structs map[string]models.Struct, t models.Type) (models.Struct, bool) {
+	model, ok := structs[t.Name]
+	if !ok {
+		return models.Struct{}, false
+	}
+
+	if _, isGeneric := t.Additional.(models.GenericAdditional); !isGeneric {
+		return model, len(model.TypeParams) == 0
+	}
+
+	model, err := generator.InstantiateModel(model, t)
+	if err != nil {
+		return models.Struct{}, false
+	}
+
+	return model, true
+}
+
+// modelFileName returns name of model for convertor file like page_user for Page[User]
+func modelFileName(t models.Type) string {
+	if additional, ok := t.Additional.(models.GenericAdditional); ok {
+		return t.Name + "_" + modelFileName(additional.TypeArg)
+	}
+
+	return t.Name
+}
+
+// nestedStructs returns structs of nested model package. Structs of other packages are parsed on demand
+// only for recursive mapping. Interface models are parsed only if source fields are read by getters
+func nestedStructs(
+	lg logger.Logger,
+	nested models.Type,
+	root models.Package,
+	rootStructs map[string]models.Struct,
+	recursive bool,
+	getters bool,
+) (map[string]models.Struct, error) {
+	if nested.Package.Path == root.Path {
+		return rootStructs, nil
+	}
+
+	// builtin types and standard library packages do not contain models
+	if !recursive || nested.Package.Path == "" || parser.IsStandardPackage(lg, nested.Package.Path) {
+		return nil, nil
+	}
+
+	structs, err := parser.ParseModelsByPackage(lg, nested.Package.Path)
+	if err != nil {
+		return nil, fmt.Errorf("parse nested models of %s error: %w", nested.Package.Path, err)
+	}
+
+	if getters {
+		return withInterfaceModels(lg, nested.Package.Path, structs)
+	}
+
+	return structs, nil
+}
+
+// addPackageAlias adds alias of package if its name is used by other package
+func addPackageAlias(aliases map[string]string, pkg models.Package) {
+	if _, ok := aliases[pkg.Path]; ok {
+		return
+	}
+
+	used := make(map[string]bool, len(aliases))
+	for pkgPath, alias := range aliases {
+		if alias == "" {
+			alias = path.Base(pkgPath)
+		}
+		used[alias] = true
+	}
+
+	if !used[pkg.Name] {
+		aliases[pkg.Path] = ""
+		return
+	}
+
+	alias := path.Base(path.Dir(pkg.Path)) + pkg.Name
+	for i := 2; used[alias]; i++ {
+		alias = fmt.Sprintf("%s%s%d", path.Base(path.Dir(pkg.Path)), pkg.Name, i)
+	}
+
+	aliases[pkg.Path] = alias
+}
+
+// isDirectConvertible checks that nested models filtered by tags can be converted by type conversion
+func isDirectConvertible(from, to models.Struct, fromTag, toTag string) bool {
+	from.Fields = utils.FilterFields(fromTag, from.Fields)
+	to.Fields = utils.FilterFields(toTag, to.Fields)
+
+	return generator.IsDirectConvertible(from, to)
+}
+
+// getComputedFields finds computed fields functions in conversion functions packages and destination package
+func getComputedFields(
+	lg logger.Logger,
+	computed []options.ComputedField,
+	computedFuncs models.ComputedFunctions,
+	destination string,
+	aliases map[string]string,
+) ([]models.ComputedField, error) {
+
+	if len(computed) == 0 {
+		return nil, nil
+	}
+
+	destinationFuncs, err := parser.ParseComputedFunctions(lg, utils.ClearFileName(destination))
+	if err != nil {
+		return nil, fmt.Errorf("parse destination computed functions %s error: %w", destination, err)
+	}
+
+	res := make([]models.ComputedField, 0, len(computed))
+	for _, field := range computed {
+		function, ok := destinationFuncs[field.Function]
+		if !ok {
+			function, ok = computedFuncs[field.Function]
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("%w: %s for field %s", ErrNotFoundFunc, field.Function, field.Field)
+		}
+
+		setPackageAlias(&function.Package, aliases)
+		res = append(res, models.ComputedField{
+			Field:    field.Field,
+			Function: function,
+			Args:     field.Args,
+		})
+	}
+
+	return res, nil
+}
+
+// mapMergedModels generates convertor of from model and merged models into one target model
+func mapMergedModels(
+	lg logger.Logger,
+	opt options.Option,
+	from, to models.Struct,
+	convertorOpts generator.ConvertorOptions,
+	computedFuncs models.ComputedFunctions,
+	aliases map[string]string,
+	funcs models.Functions,
+) error {
+
+	if opt.Inverse || opt.Recursive || opt.Mapper != "" {
+		return fmt.Errorf("%w: inverse, recursive and mapper options are not supported", ErrMergeOption)
+	}
+
+	// hooks have one source model argument
+	if opt.BeforeHook != "" || opt.AfterHook != "" {
+		return fmt.Errorf("%w: before and after hooks are not supported", ErrMergeOption)
+	}
+
+	froms := []models.Struct{from}
+	tags := []string{opt.From.Tag}
+	for _, merged := range opt.Merge {
+		structs, err := parser.ParseModelsByPackage(lg, merged.Source)
+		if err != nil {
+			return fmt.Errorf("parse models error: %w", err)
+		}
+
+		name, isPointer := parseModelName(merged.Name)
+		model, ok := structs[name]
+		if !ok {
+			return fmt.Errorf("%w: merged model %s from %s", ErrNotFoundStruct, merged.Name, merged.Source)
+		}
+		model.Type.Pointer = isPointer
+
+		if merged.Alias != "" {
+			aliases[model.Type.Package.Path] = merged.Alias
+		}
+
+		froms = append(froms, model)
+		tags = append(tags, merged.Tag)
+	}
+
+	for i := range froms {
+		froms[i].Fields = utils.FilterFields(tags[i], froms[i].Fields)
+		if len(froms[i].Fields) == 0 {
+			return fmt.Errorf(
+				"%w: source model %s does not contain tag %s",
+				ErrNotFoundTag,
+				froms[i].Type.Name,
+				tags[i],
+			)
+		}
+
+		setPackageAliasToStruct(&froms[i], aliases)
+	}
+
+	to.Fields = utils.FilterFields(opt.To.Tag, to.Fields)
+	if len(to.Fields) == 0 {
+		return fmt.Errorf(
+			"%w: to model %s does not contain tag %s",
+			ErrNotFoundTag,
+			to.Type.Name,
+			opt.To.Tag,
+		)
+	}
+
+	setPackageAliasToStruct(&to, aliases)
+
+	destination := opt.Destination
+	err := os.MkdirAll(path.Dir(destination), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("create destination dir %s error: %w", path.Dir(destination), err)
+	}
+
+	pkg, err := parser.ParseDestinationPackage(lg, destination)
+	if err != nil {
+		return fmt.Errorf("parse destination package %s error: %w", destination, err)
+	}
+
+	convertorOpts.Computed, err = getComputedFields(lg, opt.Computed, computedFuncs, destination, aliases)
+	if err != nil {
+		return err
+	}
+
+	body, pkgs, err := generator.GenerateMergedConvertor(
+		froms,
+		to,
+		pkg,
+		setPackageAliasToFunctions(funcs, aliases),
+		convertorOpts,
+	)
+	if err != nil {
+		return fmt.Errorf("generate merged convertor error: %w", err)
+	}
+
+	err = generator.CreateConvertorSource(pkg, pkgs, []string{body}, destination)
+	if err != nil {
+		return fmt.Errorf("create convertor source error: %w", err)
+	}
+	lg.Infof("generated convertor source: \"%s\"", destination)
+
+	return nil
+}
+
+// mapStructAndMap generates convertors between model and map[string]any by model tag
+func mapStructAndMap(
+	lg logger.Logger,
+	opt options.Option,
+	cfAliases map[string]string,
+	dependencies []models.Type,
+	funcs models.Functions,
+) error {
+
+	modelOpt, toMap := opt.From, true
+	if isMapModelName(opt.From.Name) {
+		modelOpt, toMap = opt.To, false
+	}
+
+	structs, err := parser.ParseModelsByPackage(lg, modelOpt.Source)
+	if err != nil {
+		return fmt.Errorf("parse models error: %w", err)
+	}
+
+	name, isPointer := parseModelName(modelOpt.Name)
+	model, ok := structs[name]
+	if !ok {
+		return fmt.Errorf("%w: model %s from %s", ErrNotFoundStruct, modelOpt.Name, modelOpt.Source)
+	}
+	model.Type.Pointer = isPointer
+
+	model.Fields = utils.FilterFields(modelOpt.Tag, model.Fields)
+	if len(model.Fields) == 0 {
+		return fmt.Errorf(
+			"%w: model %s does not contain tag %s",
+			ErrNotFoundTag,
+			model.Type.Name,
+			modelOpt.Tag,
+		)
+	}
+
+	aliases := map[string]string{
+		model.Type.Package.Path: modelOpt.Alias,
+	}
+
+	maps.Copy(aliases, cfAliases)
+	setPackageAliasToStruct(&model, aliases)
+
+	if opt.Convertor != "" {
+		err = mapConvertorStruct(lg, opt.Convertor, opt.Destination, dependencies, aliases)
+		if err != nil {
+			return err
+		}
+	}
+
+	destination := opt.Destination
+	err = os.MkdirAll(path.Dir(destination), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("create destination dir %s error: %w", path.Dir(destination), err)
+	}
+
+	pkg, err := parser.ParseDestinationPackage(lg, destination)
+	if err != nil {
+		return fmt.Errorf("parse destination package %s error: %w", destination, err)
+	}
+
+	convertorOpts := generator.ConvertorOptions{
+		Receiver: opt.Convertor,
+		Validate: opt.Validate,
+	}
+
+	var convertors []string
+	pkgs := make(models.Packages)
+
+	generateToMap := func() error {
+		body, resPkgs, err := generator.GenerateStructToMapConvertor(model, pkg, convertorOpts)
+		if err != nil {
+			return fmt.Errorf("generate convertor error: %w", err)
+		}
+
+		convertors = append(convertors, body)
+		maps.Copy(pkgs, resPkgs)
+		return nil
+	}
+
+	generateFromMap := func() error {
+		body, resPkgs, err := generator.GenerateMapToStructConvertor(
+			model,
+			pkg,
+			setPackageAliasToFunctions(funcs, aliases),
+			convertorOpts,
+		)
+		if err != nil {
+			return fmt.Errorf("generate convertor error: %w", err)
+		}
+
+		convertors = append(convertors, body)
+		maps.Copy(pkgs, resPkgs)
+		return nil
+	}
+
+	generators := []func() error{generateToMap, generateFromMap}
+	if !toMap {
+		generators = []func() error{generateFromMap, generateToMap}
+	}
+
+	if !opt.Inverse {
+		generators = generators[:1]
+	}
+
+	for _, generate := range generators {
+		if err = generate(); err != nil {
+			return err
+		}
+	}
+
+	err = generator.CreateConvertorSource(pkg, pkgs, convertors, destination)
+	if err != nil {
+		return fmt.Errorf("create convertor source error: %w", err)
+	}
+	lg.Infof("generated convertor source: \"%s\"", destination)
+
+	return nil
+}
+
+// mapClone generates deep copy function of from model
+func mapClone(lg logger.Logger, opt options.Option) error {
+	structs, err := parser.ParseModelsByPackage(lg, opt.From.Source)
+	if err != nil {
+		return fmt.Errorf("parse models error: %w", err)
+	}
+
+	name, isPointer := parseModelName(opt.From.Name)
+	model, ok := structs[name]
+	if !ok {
+		return fmt.Errorf("%w: source model %s from %s", ErrNotFoundStruct, opt.From.Name, opt.From.Source)
+	}
+
+	collections, err := parser.ParseCollectionTypesByPackage(lg, opt.From.Source)
+	if err != nil {
+		return fmt.Errorf("parse collection types error: %w", err)
+	}
+
+	aliases := map[string]string{
+		model.Type.Package.Path: opt.From.Alias,
+	}
+
+	allStructs, err := cloneStructs(lg, model.Type.Package, structs, collections, aliases)
+	if err != nil {
+		return err
+	}
+
+	aliasedStructs := make(map[models.Type]models.Struct, len(allStructs))
+	for key, current := range allStructs {
+		current.Fields = append([]models.Field(nil), current.Fields...)
+		setPackageAliasToStruct(&current, aliases)
+		aliasedStructs[key] = current
+	}
+
+	for named, underlying := range collections {
+		collections[named] = setPackageAliasToType(underlying, aliases)
+	}
+
+	root := aliasedStructs[model.Type]
+	root.Type.Pointer = isPointer
+
+	destination := opt.Destination
+	err = os.MkdirAll(path.Dir(destination), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("create destination dir %s error: %w", path.Dir(destination), err)
+	}
+
+	pkg, err := parser.ParseDestinationPackage(lg, destination)
+	if err != nil {
+		return fmt.Errorf("parse destination package %s error: %w", destination, err)
+	}
+
+	body, pkgs, err := generator.GenerateClone(root, aliasedStructs, collections, pkg)
+	if err != nil {
+		return fmt.Errorf("generate clone error: %w", err)
+	}
+
+	err = generator.CreateConvertorSource(pkg, pkgs, []string{body}, destination)
+	if err != nil {
+		return fmt.Errorf("create clone source error: %w", err)
+	}
+	lg.Infof("generated clone source: \"%s\"", destination)
+
+	return nil
+}
+
+// cloneStructs returns structs of model package and structs of other packages used by fields of cloned structs.
+// Collection types of other packages are added to collections and their packages are added to aliases
+func cloneStructs(
+	lg logger.Logger,
+	root models.Package,
+	rootStructs map[string]models.Struct,
+	collections map[models.Type]models.Type,
+	aliases map[string]string,
+) (map[models.Type]models.Struct, error) {
+	res := make(map[models.Type]models.Struct, len(rootStructs))
+	parsed := map[string]struct{}{root.Path: {}}
+
+	var queue []models.Type
+	addStructs := func(structs map[string]models.Struct) {
+		for _, current := range structs {
+			if len(current.TypeParams) != 0 {
+				continue
+			}
+
+			res[current.Type] = current
+			for _, field := range current.Fields {
+				queue = append(queue, field.Type)
+			}
+		}
+	}
+
+	addStructs(rootStructs)
+	for _, underlying := range collections {
+		queue = append(queue, underlying)
+	}
+
+	for len(queue) != 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		switch additional := current.Additional.(type) {
+		case models.SliceAdditional:
+			queue = append(queue, additional.InType)
+		case models.ArrayAdditional:
+			queue = append(queue, additional.InType)
+		case models.MapAdditional:
+			queue = append(queue, additional.ValueType)
+		}
+
+		if current.Kind != models.StructType && current.Kind != models.RedefinedType {
+			continue
+		}
+
+		if _, ok := parsed[current.Package.Path]; ok {
+			continue
+		}
+		parsed[current.Package.Path] = struct{}{}
+
+		structs, err := nestedStructs(lg, current, root, rootStructs, true, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(structs) == 0 {
+			continue
+		}
+
+		addPackageAlias(aliases, current.Package)
+		addStructs(structs)
+
+		pkgCollections, err := parser.ParseCollectionTypesByPackage(lg, current.Package.Path)
+		if err != nil {
+			return nil, fmt.Errorf("parse collection types of %s error: %w", current.Package.Path, err)
+		}
+
+		for named, underlying := range pkgCollections {
+			collections[named] = underlying
+			queue = append(queue, underlying)
+		}
+	}
+
+	return res, nil
+}
+
+func mapConvertorStruct(
+	lg logger.Logger,
+	name string,
+	destination string,
+	dependencies []models.Type,
+	aliases map[string]string,
+) error {
+
+	destination = generateStructDestination(name, destination)
+
+	err := os.MkdirAll(path.Dir(destination), os.ModePerm)
+	if err != nil {
+		return fmt.Errorf("create destination dir %s error: %w", path.Dir(destination), err)
+	}
+
+	pkg, err := parser.ParseDestinationPackage(lg, destination)
+	if err != nil {
+		return fmt.Errorf("parse destination package %s error: %w", destination, err)
+	}
+
+	deps := make([]models.Type, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		setPackageAlias(&dependency.Package, aliases)
+		deps = append(deps, dependency)
+	}
+
+	body, pkgs, err := generator.GenerateConvertorStruct(name, pkg, deps)
+	if err != nil {
+		return fmt.Errorf("generate convertor struct error: %w", err)
+	}
+
+	err = generator.CreateConvertorSource(pkg, pkgs, []string{body}, destination)
+	if err != nil {
+		return fmt.Errorf("create convertor struct source error: %w", err)
+	}
+	lg.Infof("generated convertor struct source: \"%s\"", destination)
+
+	return nil
+}
+
+func generateStructDestination(structName, dest string) string {
+	fileName := strings.ToLower(fmt.Sprintf("%s.go", structName))
+	dir := utils.ClearFileName(dest)
+	return fmt.Sprintf("%s/%s", dir, fileName)
+}
+
 func generateDestination(typeName, dest string) string {
 	fileName := strings.ToLower(fmt.Sprintf("%s_converter.go", typeName))
 	dir := utils.ClearFileName(dest)
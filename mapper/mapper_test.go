@@ -1,13 +1,22 @@
 package mapper
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/http"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/underbek/datamapper/_test_data"
+	clonepointer "github.com/underbek/datamapper/_test_data/mapper/expected/clone_pointer"
+	withmap "github.com/underbek/datamapper/_test_data/mapper/expected/with_map"
+	"github.com/underbek/datamapper/_test_data/mapper/tree"
+	"github.com/underbek/datamapper/_test_data/mapper/tree/labels"
+	"github.com/underbek/datamapper/converts/checked"
+	"github.com/underbek/datamapper/generator"
 	"github.com/underbek/datamapper/logger"
 	"github.com/underbek/datamapper/options"
 )
@@ -26,6 +35,30 @@ const (
 	otherCFPath           = "../_test_data/mapper/other_convertors"
 	recursiveFrom         = "../_test_data/mapper/recursive/from"
 	recursiveTo           = "../_test_data/mapper/recursive/to"
+	numericSource         = "../_test_data/mapper/numeric"
+	numericCFPath         = "../_test_data/mapper/numeric/convertors"
+	querySource           = "../_test_data/mapper/query"
+	contextCFPath         = "../_test_data/mapper/context_convertors"
+	dependenciesPath      = "../_test_data/mapper/dependencies"
+	hooksPath             = "../_test_data/mapper/hooks"
+	validateSource        = "../_test_data/mapper/validate"
+	computedSource        = "../_test_data/mapper/computed"
+	mergeSource           = "../_test_data/mapper/merge"
+	dynamicSource         = "../_test_data/mapper/dynamic"
+	cloneSource           = "../_test_data/mapper/tree"
+	directSource          = "../_test_data/mapper/direct"
+	directDTOSource       = "../_test_data/mapper/direct/dto"
+	chainSource           = "../_test_data/mapper/chain"
+	commentsSource        = "../_test_data/mapper/comments"
+	pairsFrom             = "../_test_data/mapper/pairs/domain"
+	pairsTo               = "../_test_data/mapper/pairs/transport"
+	nestedFrom            = "../_test_data/mapper/nested/domain"
+	nestedTo              = "../_test_data/mapper/nested/transport"
+	dotlessModule         = "../_test_data/mapper/myapp"
+	cycleSource           = "../_test_data/mapper/cycle"
+	genericSource         = "../_test_data/mapper/generic"
+	gettersSource         = "../_test_data/mapper/getters"
+	constructSource       = "../_test_data/mapper/construct"
 
 	destination     = "../_test_data/generated/mapper/user_convertor.go"
 	destinationPath = "../_test_data/generated/mapper"
@@ -348,144 +381,1364 @@ func Test_MapModels(t *testing.T) {
 			},
 			expectedPath: "with_generated",
 		},
+		{
+			name: "With checked numeric conversions",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: numericSource,
+							Name:   "Payment",
+							Tag:    "checked",
+						},
+						To: options.Model{
+							Source: numericSource,
+							Name:   "PaymentDTO",
+							Tag:    "checked",
+						},
+						Inverse: true,
+						Checked: true,
+					},
+				},
+			},
+			expectedPath: "checked",
+		},
+		{
+			name: "With checked numeric conversions and user functions",
+			opts: options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: numericCFPath},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: numericSource,
+							Name:   "Payment",
+							Tag:    "checked",
+						},
+						To: options.Model{
+							Source: numericSource,
+							Name:   "PaymentDTO",
+							Tag:    "checked",
+						},
+						Checked: true,
+					},
+				},
+			},
+			expectedPath: "checked_with_cf",
+		},
+		{
+			name: "With string parsing",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: querySource,
+							Name:   "Params",
+							Tag:    "query",
+						},
+						To: options.Model{
+							Source: querySource,
+							Name:   "Filter",
+							Tag:    "query",
+						},
+						Inverse: true,
+					},
+				},
+			},
+			expectedPath: "string_parsing",
+		},
+		{
+			name: "With mapper",
+			opts: options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: customCFPath},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: mapperTransportSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: mapperDomainSource,
+							Name:   "User",
+							Tag:    toModelTag,
+						},
+						Inverse: true,
+						Mapper:  "UserMapper",
+					},
+				},
+			},
+			expectedPath: "with_mapper",
+		},
+		{
+			name: "With hooks",
+			opts: options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: customCFPath},
+					{Source: hooksPath},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: mapperTransportSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: mapperDomainSource,
+							Name:   "User",
+							Tag:    toModelTag,
+						},
+						Inverse:   true,
+						AfterHook: "NormalizeUserName",
+					},
+				},
+			},
+			expectedPath: "with_hooks",
+		},
+		{
+			name: "With validate",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: validateSource,
+							Name:   "AccountDTO",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: validateSource,
+							Name:   "*Account",
+							Tag:    toModelTag,
+						},
+						Inverse:  true,
+						Validate: true,
+					},
+				},
+			},
+			expectedPath: "with_validate",
+		},
+		{
+			name: "With computed fields",
+			opts: options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: computedSource},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: computedSource,
+							Name:   "Person",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: computedSource,
+							Name:   "Profile",
+							Tag:    toModelTag,
+						},
+						Computed: []options.ComputedField{
+							{Field: "full_name", Function: "FullName", Args: []string{"first_name", "last_name"}},
+							{Field: "balance", Function: "NewMoney", Args: []string{"amount", "currency"}},
+						},
+					},
+				},
+			},
+			expectedPath: "with_computed",
+		},
+		{
+			name: "With merged models",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: mergeSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						Merge: []options.Model{
+							{
+								Source: mergeSource,
+								Name:   "*Account",
+								Tag:    "account",
+							},
+						},
+						To: options.Model{
+							Source: mergeSource,
+							Name:   "Profile",
+							Tag:    toModelTag,
+						},
+					},
+				},
+			},
+			expectedPath: "with_merge",
+		},
+		{
+			name: "With merged models sharing unused tag",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: mergeSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						Merge: []options.Model{
+							{
+								Source: mergeSource,
+								Name:   "Settings",
+								Tag:    modelTag,
+							},
+						},
+						To: options.Model{
+							Source: mergeSource,
+							Name:   "Appearance",
+							Tag:    toModelTag,
+						},
+					},
+				},
+			},
+			expectedPath: "with_merge_unused_tag",
+		},
+		{
+			name: "With map",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: dynamicSource,
+							Name:   "*Event",
+							Tag:    "attr",
+						},
+						To: options.Model{
+							Name: "map[string]any",
+						},
+						Inverse: true,
+						Checked: true,
+					},
+				},
+			},
+			expectedPath: "with_map",
+		},
+		{
+			name: "With unchecked map",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Name: "map[string]any",
+						},
+						To: options.Model{
+							Source: dynamicSource,
+							Name:   "Event",
+							Tag:    "attr",
+						},
+					},
+				},
+			},
+			expectedPath: "with_map_unchecked",
+		},
+		{
+			name: "With direct conversion",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: directSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: directSource,
+							Name:   "UserDTO",
+							Tag:    toModelTag,
+						},
+						Inverse: true,
+					},
+				},
+			},
+			expectedPath: "with_direct",
+		},
+		{
+			name: "With direct conversion of other packages",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: directSource,
+							Name:   "Event",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: directDTOSource,
+							Name:   "Event",
+							Tag:    toModelTag,
+						},
+						Inverse: true,
+					},
+				},
+			},
+			expectedPath: "with_direct_packages",
+		},
+		{
+			name: "With conversion chains",
+			opts: options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: chainSource},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: chainSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: chainSource,
+							Name:   "UserDTO",
+							Tag:    toModelTag,
+						},
+						Inverse:  true,
+						MaxChain: 2,
+					},
+				},
+			},
+			expectedPath: "with_chain",
+		},
+		{
+			name: "Clone",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: cloneSource,
+							Name:   "Meta",
+						},
+						Clone: true,
+					},
+				},
+			},
+			expectedPath: "clone",
+		},
+		{
+			name: "Clone with pointers",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: cloneSource,
+							Name:   "Node",
+						},
+						Clone: true,
+					},
+				},
+			},
+			expectedPath: "clone_with_pointers",
+		},
+		{
+			name: "Clone pointer",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: cloneSource,
+							Name:   "*Node",
+						},
+						Clone: true,
+					},
+				},
+			},
+			expectedPath: "clone_pointer",
+		},
+	}
+
+	lg := logger.New()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer clearDestination(t, destinationPath)
+
+			err := MapModels(lg, tt.opts)
+			require.NoError(t, err)
+
+			actual := readActual(t)
+			expected := _test_data.MapperExpected(t, tt.expectedPath)
+			assert.Equal(t, expected, actual)
+		})
+	}
+}
+
+func Test_ConvertMapToStructByExpectedConvertor(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		expected []any
+		err      error
+		message  string
+	}{
+		{
+			name: "Decoded json values",
+			json: `{"name": "event", "count": 3, "level": -7, "tags": ["a", "b"], "scores": [1, 2.0, "3"],
+				"extra": [1, "a"]}`,
+			expected: []any{"event", 3, int8(-7), []string{"a", "b"}, []int{1, 2, 3}, []any{1.0, "a"}},
+		},
+		{
+			name: "Fractional number",
+			json: `{"count": 1.9}`,
+			err:  checked.ErrOverflow,
+		},
+		{
+			name: "Overflowed number",
+			json: `{"level": 300}`,
+			err:  checked.ErrOverflow,
+		},
+		{
+			name:    "Fractional item",
+			json:    `{"scores": [1, 2.5]}`,
+			err:     checked.ErrOverflow,
+			message: "map[scores][1]",
+		},
+		{
+			name: "Unexpected item type",
+			json: `{"tags": ["a", {"b": "c"}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var from map[string]any
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &from))
+
+			res, err := withmap.ConvertMapToDynamicEvent(from)
+			if tt.expected == nil {
+				require.Error(t, err)
+				if tt.err != nil {
+					assert.ErrorIs(t, err, tt.err)
+				}
+				if tt.message != "" {
+					assert.ErrorContains(t, err, tt.message)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, []any{res.Name, res.Count, res.Level, res.Tags, res.Scores, res.Extra})
+		})
+	}
+}
+
+func Test_CloneByExpectedClone(t *testing.T) {
+	root := &tree.Node{
+		Name:    "root",
+		Tags:    tree.Tags{"a"},
+		Headers: http.Header{"Accept": {"text/plain"}},
+		Address: net.IPv4(127, 0, 0, 1),
+		Meta: tree.Meta{
+			Attributes: tree.Attributes{"size": {Values: []int{1}}},
+			Label:      labels.Label{Name: "color", Values: []string{"red"}},
+		},
+	}
+	child := &tree.Node{Name: "child", Parent: root}
+	root.Children = []*tree.Node{child}
+	root.Siblings = tree.Nodes{child}
+
+	res := clonepointer.CloneTreeNode(root)
+
+	require.NotSame(t, root, res)
+	require.Len(t, res.Children, 1)
+	assert.Same(t, res, res.Children[0].Parent)
+	assert.Same(t, res.Children[0], res.Siblings[0])
+
+	root.Tags[0] = "b"
+	root.Headers["Accept"][0] = "text/html"
+	root.Address[15] = 2
+	root.Meta.Attributes["size"].Values[0] = 2
+	root.Meta.Label.Values[0] = "blue"
+
+	assert.Equal(t, tree.Tags{"a"}, res.Tags)
+	assert.Equal(t, http.Header{"Accept": {"text/plain"}}, res.Headers)
+	assert.Equal(t, net.IPv4(127, 0, 0, 1), res.Address)
+	assert.Equal(t, []int{1}, res.Meta.Attributes["size"].Values)
+	assert.Equal(t, []string{"red"}, res.Meta.Label.Values)
+	assert.Nil(t, clonepointer.CloneTreeNode(nil))
+}
+
+func Test_MapModelsWithNotFoundHook(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		ConversionFunctions: []options.ConversionFunction{
+			{Source: customCFPath},
+			{Source: hooksPath},
+		},
+		Options: []options.Option{
+			{
+				Destination: destination,
+				From: options.Model{
+					Source: mapperDomainSource,
+					Name:   "User",
+					Tag:    modelTag,
+				},
+				To: options.Model{
+					Source: mapperTransportSource,
+					Name:   "User",
+					Tag:    toModelTag,
+				},
+				AfterHook: "NormalizeUserName",
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.ErrorIs(t, err, generator.ErrNotFoundHook)
+}
+
+func Test_MapModelsWithIncorrectComputedFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		computed []options.ComputedField
+		err      error
+	}{
+		{
+			name:     "Not found function",
+			computed: []options.ComputedField{{Field: "full_name", Function: "Incorrect"}},
+			err:      ErrNotFoundFunc,
+		},
+		{
+			name: "Incorrect args count",
+			computed: []options.ComputedField{
+				{Field: "full_name", Function: "FullName", Args: []string{"first_name"}},
+			},
+			err: generator.ErrComputedField,
+		},
+		{
+			name: "Incorrect arg type",
+			computed: []options.ComputedField{
+				{Field: "full_name", Function: "FullName", Args: []string{"first_name", "amount"}},
+			},
+			err: generator.ErrComputedField,
+		},
+		{
+			name: "Incorrect result type",
+			computed: []options.ComputedField{
+				{Field: "id", Function: "FullName", Args: []string{"first_name", "last_name"}},
+			},
+			err: generator.ErrComputedField,
+		},
+		{
+			name: "Not found target field",
+			computed: []options.ComputedField{
+				{Field: "name", Function: "FullName", Args: []string{"first_name", "last_name"}},
+			},
+			err: generator.ErrComputedField,
+		},
+	}
+
+	lg := logger.New()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer clearDestination(t, destinationPath)
+
+			opts := options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: computedSource},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From: options.Model{
+							Source: computedSource,
+							Name:   "Person",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: computedSource,
+							Name:   "Profile",
+							Tag:    toModelTag,
+						},
+						Computed: tt.computed,
+					},
+				},
+			}
+
+			err := MapModels(lg, opts)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func Test_MapMergedModelsWithConflict(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination: destination,
+				From: options.Model{
+					Source: mergeSource,
+					Name:   "User",
+					Tag:    modelTag,
+				},
+				Merge: []options.Model{
+					{
+						Source: mergeSource,
+						Name:   "Settings",
+						Tag:    modelTag,
+					},
+				},
+				To: options.Model{
+					Source: mergeSource,
+					Name:   "Profile",
+					Tag:    toModelTag,
+				},
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.ErrorIs(t, err, generator.ErrMergeConflict)
+}
+
+func Test_MapMergedModelsWithHooks(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination: destination,
+				From: options.Model{
+					Source: mergeSource,
+					Name:   "User",
+					Tag:    modelTag,
+				},
+				Merge: []options.Model{
+					{
+						Source: mergeSource,
+						Name:   "*Account",
+						Tag:    "account",
+					},
+				},
+				To: options.Model{
+					Source: mergeSource,
+					Name:   "Profile",
+					Tag:    toModelTag,
+				},
+				AfterHook: "NormalizeProfile",
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.ErrorIs(t, err, ErrMergeOption)
+}
+
+func Test_MapRecursiveModels(t *testing.T) {
+	from := options.Model{
+		Source: recursiveFrom,
+		Name:   "Order",
+		Tag:    recursiveTag,
+		Alias:  "f",
+	}
+
+	to := options.Model{
+		Source: recursiveTo,
+		Name:   "Order",
+		Tag:    recursiveTag,
+		Alias:  "t",
+	}
+
+	destination := "../_test_data/generated/mapper/order.go"
+
+	tests := []struct {
+		name         string
+		opts         options.Options
+		isError      bool
+		expectedPath string
+	}{
+		{
+			name:    "without recursive",
+			isError: true,
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						From:        from,
+						To:          to,
+					},
+				},
+			},
+		},
+		{
+			name:         "recursive",
+			expectedPath: "recursive",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						Recursive:   true,
+						From:        from,
+						To:          to,
+					},
+				},
+			},
+		},
+		{
+			name:         "recursive with inverse",
+			expectedPath: "recursive_with_inverse",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						Recursive:   true,
+						Inverse:     true,
+						From:        from,
+						To:          to,
+					},
+				},
+			},
+		},
+		{
+			name:         "recursive with inverse and mapper",
+			expectedPath: "recursive_with_mapper",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination: destination,
+						Recursive:   true,
+						Inverse:     true,
+						Mapper:      "OrderMapper",
+						From:        from,
+						To:          to,
+					},
+				},
+			},
+		},
+		{
+			name:         "recursive with pointers",
+			expectedPath: "recursive_with_pointers",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination:  destination,
+						Recursive:    true,
+						WithPointers: true,
+						From:         from,
+						To:           to,
+					},
+				},
+			},
+		},
+		{
+			name:         "recursive with inverse and pointers",
+			expectedPath: "recursive_with_inverse_and_pointers",
+			opts: options.Options{
+				Options: []options.Option{
+					{
+						Destination:  destination,
+						Recursive:    true,
+						Inverse:      true,
+						WithPointers: true,
+						From:         from,
+						To:           to,
+					},
+				},
+			},
+		},
+		{
+			name:         "recursive with context",
+			expectedPath: "recursive_with_context",
+			opts: options.Options{
+				ConversionFunctions: []options.ConversionFunction{
+					{Source: contextCFPath},
+				},
+				Options: []options.Option{
+					{
+						Destination: destination,
+						Recursive:   true,
+						From:        from,
+						To:          to,
+					},
+				},
+			},
+		},
+	}
+
+	lg := logger.New()
+
+	converters := []string{
+		"account_converter.go",
+		"operation_converter.go",
+		"order.go",
+		"user_converter.go",
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer clearDestination(t, destinationPath)
+
+			err := MapModels(lg, tt.opts)
+			if tt.isError {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			for _, converterName := range converters {
+				actual := readFile(t, converterName)
+				expected := _test_data.MapperExpectedFile(t, tt.expectedPath, converterName)
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func Test_MapModelsWithConvertorStruct(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		ConversionFunctions: []options.ConversionFunction{
+			{Source: dependenciesPath, Struct: "Rates"},
+		},
+		Options: []options.Option{
+			{
+				Destination: "../_test_data/generated/mapper/order.go",
+				Recursive:   true,
+				Convertor:   "OrderConvertor",
+				Mapper:      "OrderMapper",
+				From: options.Model{
+					Source: recursiveFrom,
+					Name:   "Order",
+					Tag:    recursiveTag,
+					Alias:  "f",
+				},
+				To: options.Model{
+					Source: recursiveTo,
+					Name:   "Order",
+					Tag:    recursiveTag,
+					Alias:  "t",
+				},
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.NoError(t, err)
+
+	converters := []string{
+		"account_converter.go",
+		"operation_converter.go",
+		"order.go",
+		"user_converter.go",
+		"orderconvertor.go",
+	}
+
+	for _, converterName := range converters {
+		actual := readFile(t, converterName)
+		expected := _test_data.MapperExpectedFile(t, "with_convertor_struct", converterName)
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func Test_MapModelsMethodsWithoutConvertorStruct(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		ConversionFunctions: []options.ConversionFunction{
+			{Source: dependenciesPath, Struct: "Rates"},
+		},
+		Options: []options.Option{
+			{
+				Destination: "../_test_data/generated/mapper/order.go",
+				Recursive:   true,
+				From: options.Model{
+					Source: recursiveFrom,
+					Name:   "Order",
+					Tag:    recursiveTag,
+				},
+				To: options.Model{
+					Source: recursiveTo,
+					Name:   "Order",
+					Tag:    recursiveTag,
+				},
+			},
+		},
 	}
 
-	lg := logger.New()
+	err := MapModels(logger.New(), opts)
+	require.ErrorIs(t, err, generator.ErrMethodWithoutReceiver)
+}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			defer clearDestination(t, destinationPath)
+func Test_MapModelsByComments(t *testing.T) {
+	defer clearDestination(t, destinationPath)
 
-			err := MapModels(lg, tt.opts)
-			require.NoError(t, err)
+	err := MapModels(logger.New(), options.Options{Scan: []string{commentsSource}})
+	require.NoError(t, err)
 
-			actual := readActual(t)
-			expected := _test_data.MapperExpected(t, tt.expectedPath)
-			assert.Equal(t, expected, actual)
-		})
+	for _, converterName := range []string{"dto_convertor.go", "dao_convertor.go"} {
+		actual := readFile(t, converterName)
+		expected := _test_data.MapperExpectedFile(t, "with_comments", converterName)
+		assert.Equal(t, expected, actual)
 	}
 }
 
-func Test_MapRecursiveModels(t *testing.T) {
-	from := options.Model{
-		Source: recursiveFrom,
-		Name:   "Order",
-		Tag:    recursiveTag,
-		Alias:  "f",
+func Test_MapPairedModels(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination: destination,
+				From: options.Model{
+					Source: pairsFrom,
+					Tag:    modelTag,
+				},
+				To: options.Model{
+					Source: pairsTo,
+					Tag:    "json",
+				},
+				Inverse:     true,
+				Pair:        true,
+				PairPattern: "{Name}DTO",
+			},
+		},
 	}
 
-	to := options.Model{
-		Source: recursiveTo,
-		Name:   "Order",
-		Tag:    recursiveTag,
-		Alias:  "t",
+	err := MapModels(logger.New(), opts)
+	require.NoError(t, err)
+
+	entries, err := os.ReadDir(destinationPath)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	for _, converterName := range []string{"order_converter.go", "user_converter.go"} {
+		actual := readFile(t, converterName)
+		expected := _test_data.MapperExpectedFile(t, "with_pairs", converterName)
+		assert.Equal(t, expected, actual)
 	}
+}
 
-	destination := "../_test_data/generated/mapper/order.go"
+func Test_MapModelsByPattern(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination: destinationPath + "/{name}_converter.go",
+				From: options.Model{
+					Source: pairsFrom,
+					Name:   "^(Order|User)$",
+					Tag:    modelTag,
+				},
+				To: options.Model{
+					Source: pairsTo,
+					Name:   "{Name}DTO",
+					Tag:    "json",
+				},
+				Inverse: true,
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.NoError(t, err)
+
+	for _, converterName := range []string{"order_converter.go", "user_converter.go"} {
+		actual := readFile(t, converterName)
+		expected := _test_data.MapperExpectedFile(t, "with_pairs", converterName)
+		assert.Equal(t, expected, actual)
+	}
+}
+
+func Test_MapModelsByNotMatchedPattern(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination: destination,
+				From: options.Model{
+					Source: pairsFrom,
+					Name:   "Invoice*",
+					Tag:    modelTag,
+				},
+				To: options.Model{
+					Source: pairsTo,
+					Name:   "{Name}DTO",
+					Tag:    "json",
+				},
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.ErrorIs(t, err, ErrNotFoundStruct)
+}
+
+func Test_MapRecursiveModelsOfOtherPackages(t *testing.T) {
+	defer clearDestination(t, destinationPath)
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination:  destination,
+				Recursive:    true,
+				Inverse:      true,
+				WithPointers: true,
+				From: options.Model{
+					Source: nestedFrom,
+					Name:   "Order",
+					Tag:    modelTag,
+				},
+				To: options.Model{
+					Source: nestedTo,
+					Name:   "Order",
+					Tag:    toModelTag,
+				},
+			},
+		},
+	}
+
+	err := MapModels(logger.New(), opts)
+	require.NoError(t, err)
 
+	for _, converterName := range []string{"user_convertor.go", "item_converter.go", "money_converter.go"} {
+		actual := readFile(t, converterName)
+		expected := _test_data.MapperExpectedFile(t, "with_nested_packages", converterName)
+		assert.Equal(t, expected, actual)
+	}
+}
+
+// Test_MapRecursiveModelsOfDotlessModule maps models of module with path without dots like myapp,
+// so mapping is run from module directory
+func Test_MapRecursiveModelsOfDotlessModule(t *testing.T) {
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+
+	require.NoError(t, os.Chdir(dotlessModule))
+	defer func() { require.NoError(t, os.Chdir(wd)) }()
+	defer clearDestination(t, "generated")
+
+	opts := options.Options{
+		Options: []options.Option{
+			{
+				Destination: "generated/order_convertor.go",
+				Recursive:   true,
+				Inverse:     true,
+				From: options.Model{
+					Source: "domain",
+					Name:   "Order",
+					Tag:    modelTag,
+				},
+				To: options.Model{
+					Source: "transport",
+					Name:   "Order",
+					Tag:    toModelTag,
+				},
+			},
+		},
+	}
+
+	err = MapModels(logger.New(), opts)
+	require.NoError(t, err)
+
+	for _, converterName := range []string{"order_convertor.go", "money_converter.go"} {
+		actual, err := os.ReadFile("generated/" + converterName)
+		require.NoError(t, err)
+
+		expected := _test_data.MapperExpectedFile(t, "with_dotless_module", converterName)
+		assert.Equal(t, expected, string(actual))
+	}
+}
+
+func Test_MapRecursiveCyclicModels(t *testing.T) {
 	tests := []struct {
 		name         string
-		opts         options.Options
-		isError      bool
+		withPointers bool
 		expectedPath string
 	}{
 		{
-			name:    "without recursive",
-			isError: true,
-			opts: options.Options{
-				Options: []options.Option{
-					{
-						Destination: destination,
-						From:        from,
-						To:          to,
-					},
-				},
-			},
+			name:         "without pointers",
+			expectedPath: "with_cycle",
 		},
 		{
-			name:         "recursive",
-			expectedPath: "recursive",
-			opts: options.Options{
+			name:         "with pointers",
+			withPointers: true,
+			expectedPath: "with_cycle_pointers",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer clearDestination(t, destinationPath)
+
+			opts := options.Options{
 				Options: []options.Option{
 					{
-						Destination: destination,
-						Recursive:   true,
-						From:        from,
-						To:          to,
+						Destination:  destination,
+						Recursive:    true,
+						Inverse:      true,
+						WithPointers: tt.withPointers,
+						From: options.Model{
+							Source: cycleSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: cycleSource,
+							Name:   "UserDTO",
+							Tag:    toModelTag,
+						},
 					},
 				},
-			},
+			}
+
+			err := MapModels(logger.New(), opts)
+			require.NoError(t, err)
+
+			entries, err := os.ReadDir(destinationPath)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+
+			for _, converterName := range []string{"user_convertor.go", "department_converter.go"} {
+				actual := readFile(t, converterName)
+				expected := _test_data.MapperExpectedFile(t, tt.expectedPath, converterName)
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func Test_MapGenericModels(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         string
+		to           string
+		converters   []string
+		expectedPath string
+	}{
+		{
+			name:         "nested generic model",
+			from:         "Users",
+			to:           "UsersDTO",
+			converters:   []string{"user_convertor.go", "page_user_converter.go", "user_converter.go"},
+			expectedPath: "with_generic",
 		},
 		{
-			name:         "recursive with inverse",
-			expectedPath: "recursive_with_inverse",
-			opts: options.Options{
+			name:         "generic model instantiation",
+			from:         "Page[User]",
+			to:           "Page[UserDTO]",
+			converters:   []string{"user_convertor.go", "user_converter.go"},
+			expectedPath: "with_generic_instantiation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer clearDestination(t, destinationPath)
+
+			opts := options.Options{
 				Options: []options.Option{
 					{
 						Destination: destination,
 						Recursive:   true,
 						Inverse:     true,
-						From:        from,
-						To:          to,
+						From: options.Model{
+							Source: genericSource,
+							Name:   tt.from,
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: genericSource,
+							Name:   tt.to,
+							Tag:    toModelTag,
+						},
 					},
 				},
-			},
+			}
+
+			err := MapModels(logger.New(), opts)
+			require.NoError(t, err)
+
+			for _, converterName := range tt.converters {
+				actual := readFile(t, converterName)
+				expected := _test_data.MapperExpectedFile(t, tt.expectedPath, converterName)
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func Test_MapModelsWithGetters(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         string
+		to           string
+		inverse      bool
+		converters   []string
+		expectedPath string
+		err          error
+	}{
+		{
+			name:         "struct with getters",
+			from:         "*User",
+			to:           "UserDTO",
+			converters:   []string{"user_convertor.go", "address_converter.go"},
+			expectedPath: "with_getters",
 		},
 		{
-			name:         "recursive with pointers",
-			expectedPath: "recursive_with_pointers",
-			opts: options.Options{
-				Options: []options.Option{
-					{
-						Destination:  destination,
-						Recursive:    true,
-						WithPointers: true,
-						From:         from,
-						To:           to,
-					},
-				},
-			},
+			name:         "interface with getters",
+			from:         "Profile",
+			to:           "ProfileDTO",
+			converters:   []string{"user_convertor.go"},
+			expectedPath: "with_interface_getters",
 		},
 		{
-			name:         "recursive with inverse and pointers",
-			expectedPath: "recursive_with_inverse_and_pointers",
-			opts: options.Options{
+			name:    "interface target",
+			from:    "Profile",
+			to:      "ProfileDTO",
+			inverse: true,
+			err:     generator.ErrInterfaceTarget,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer clearDestination(t, destinationPath)
+
+			opts := options.Options{
 				Options: []options.Option{
 					{
 						Destination:  destination,
 						Recursive:    true,
-						Inverse:      true,
 						WithPointers: true,
-						From:         from,
-						To:           to,
+						Getters:      true,
+						Inverse:      tt.inverse,
+						From: options.Model{
+							Source: gettersSource,
+							Name:   tt.from,
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: gettersSource,
+							Name:   tt.to,
+							Tag:    toModelTag,
+						},
 					},
 				},
-			},
-		},
-	}
+			}
 
-	lg := logger.New()
+			err := MapModels(logger.New(), opts)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
 
-	converters := []string{
-		"account_converter.go",
-		"operation_converter.go",
-		"order.go",
-		"user_converter.go",
+			require.NoError(t, err)
+
+			for _, converterName := range tt.converters {
+				actual := readFile(t, converterName)
+				expected := _test_data.MapperExpectedFile(t, tt.expectedPath, converterName)
+				assert.Equal(t, expected, actual)
+			}
+		})
+	}
+}
+
+func Test_MapModelsByConstructors(t *testing.T) {
+	tests := []struct {
+		name              string
+		to                string
+		constructor       string
+		constructorParams map[string]string
+		converters        []string
+		expectedPath      string
+		err               error
+	}{
+		{
+			name:              "pointer target",
+			to:                "*Customer",
+			constructorParams: map[string]string{"fullName": "name"},
+			converters:        []string{"user_convertor.go", "address_converter.go"},
+			expectedPath:      "with_constructor",
+		},
+		{
+			name:              "value target",
+			to:                "Customer",
+			constructor:       "NewCustomer",
+			constructorParams: map[string]string{"fullName": "name"},
+			converters:        []string{"user_convertor.go"},
+			expectedPath:      "with_constructor_value",
+		},
+		{
+			name:              "not found constructor",
+			to:                "Customer",
+			constructor:       "CreateCustomer",
+			constructorParams: map[string]string{"fullName": "name"},
+			err:               generator.ErrNotFound,
+		},
+		{
+			name: "not matched constructor params",
+			to:   "Customer",
+			err:  generator.ErrConstruction,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			defer clearDestination(t, destinationPath)
 
-			err := MapModels(lg, tt.opts)
-			if tt.isError {
-				require.Error(t, err)
+			opts := options.Options{
+				Options: []options.Option{
+					{
+						Destination:       destination,
+						Recursive:         true,
+						Construct:         true,
+						Constructor:       tt.constructor,
+						ConstructorParams: tt.constructorParams,
+						From: options.Model{
+							Source: constructSource,
+							Name:   "User",
+							Tag:    modelTag,
+						},
+						To: options.Model{
+							Source: constructSource,
+							Name:   tt.to,
+							Tag:    modelTag,
+						},
+					},
+				},
+			}
+
+			err := MapModels(logger.New(), opts)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
 				return
 			}
 
 			require.NoError(t, err)
 
-			for _, converterName := range converters {
+			for _, converterName := range tt.converters {
 				actual := readFile(t, converterName)
 				expected := _test_data.MapperExpectedFile(t, tt.expectedPath, converterName)
 				assert.Equal(t, expected, actual)
@@ -0,0 +1,140 @@
+package mapper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/options"
+	"github.com/underbek/datamapper/parser"
+	"github.com/underbek/datamapper/utils"
+)
+
+const (
+	pairNamePlaceholder = "{Name}"
+	defaultPairPattern  = pairNamePlaceholder
+)
+
+// expandOptions replaces options with paired models or models names patterns by options of each models pair
+func expandOptions(lg logger.Logger, opts []options.Option) ([]options.Option, error) {
+	res := make([]options.Option, 0, len(opts))
+	for _, opt := range opts {
+		switch {
+		case opt.Pair:
+			paired, err := pairOptions(lg, opt)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, paired...)
+		case isModelPattern(opt.From.Name):
+			matched, err := patternOptions(lg, opt)
+			if err != nil {
+				return nil, err
+			}
+
+			res = append(res, matched...)
+		default:
+			res = append(res, opt)
+		}
+	}
+
+	return res, nil
+}
+
+// pairOptions pairs models of from and to sources by names and pattern like {Name}DTO
+// and creates option for each pair which models have fields with the same tags values
+func pairOptions(lg logger.Logger, opt options.Option) ([]options.Option, error) {
+	fromStructs, err := parser.ParseModelsByPackage(lg, opt.From.Source)
+	if err != nil {
+		return nil, fmt.Errorf("parse models error: %w", err)
+	}
+
+	if opt.Getters {
+		fromStructs, err = withInterfaceModels(lg, opt.From.Source, fromStructs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	toStructs, err := parser.ParseModelsByPackage(lg, opt.To.Source)
+	if err != nil {
+		return nil, fmt.Errorf("parse models error: %w", err)
+	}
+
+	pattern := opt.PairPattern
+	if pattern == "" {
+		pattern = defaultPairPattern
+	}
+
+	names := make([]string, 0, len(fromStructs))
+	for name := range fromStructs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var res []options.Option
+	pairedFrom := make(map[string]bool)
+	pairedTo := make(map[string]bool)
+	for _, name := range names {
+		toName := modelNameByTemplate(pattern, name)
+		to, ok := toStructs[toName]
+		if !ok || to.Type == fromStructs[name].Type {
+			continue
+		}
+
+		if !hasSharedTags(fromStructs[name], to, opt.From.Tag, opt.To.Tag) {
+			lg.Warn(fmt.Sprintf("models %s and %s do not have fields with the same tags values", name, toName))
+			continue
+		}
+
+		pairedFrom[name] = true
+		pairedTo[toName] = true
+
+		pairOpt := opt
+		pairOpt.Pair = false
+		pairOpt.From.Name = name
+		pairOpt.To.Name = toName
+		pairOpt.Destination = modelDestination(name, opt.Destination)
+		res = append(res, pairOpt)
+	}
+
+	// models of the same package paired as target models are not reported as unpaired source models
+	reportUnpaired(lg, opt.From.Source, fromStructs, pairedFrom, pairedTo)
+	reportUnpaired(lg, opt.To.Source, toStructs, pairedTo, pairedFrom)
+
+	return res, nil
+}
+
+func hasSharedTags(from, to models.Struct, fromTag, toTag string) bool {
+	values := make(map[string]bool)
+	for _, field := range utils.FilterFields(fromTag, from.Fields) {
+		values[field.Tags[0].Value] = true
+	}
+
+	for _, field := range utils.FilterFields(toTag, to.Fields) {
+		if values[field.Tags[0].Value] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reportUnpaired(lg logger.Logger, source string, structs map[string]models.Struct, paired, other map[string]bool) {
+	var unpaired []string
+	for name := range structs {
+		if !paired[name] && !other[name] {
+			unpaired = append(unpaired, name)
+		}
+	}
+
+	if len(unpaired) == 0 {
+		return
+	}
+
+	sort.Strings(unpaired)
+	lg.Warn(fmt.Sprintf("unpaired models of %s: %s", source, strings.Join(unpaired, ", ")))
+}
@@ -0,0 +1,145 @@
+package mapper
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/options"
+	"github.com/underbek/datamapper/parser"
+)
+
+const (
+	regexpPrefix         = "^"
+	globSymbols          = "*?["
+	lowerNamePlaceholder = "{name}"
+)
+
+var ErrIncorrectPattern = errors.New("incorrect model name pattern error")
+
+// isModelPattern checks that model name is a regexp like ^(Order|Invoice)$ or a glob like User*.
+// Leading * of glob is a pointer like name of model
+func isModelPattern(modelName string) bool {
+	if strings.HasPrefix(modelName, regexpPrefix) {
+		return true
+	}
+
+	// map model name like map[string]any is not a pattern
+	if isMapModelName(modelName) {
+		return false
+	}
+
+	name, _ := parseModelName(modelName)
+
+	// generic model instantiation like Page[User] is not a pattern
+	if name, arg, ok := parseGenericModelName(name); ok {
+		arg, _ = parseModelName(arg)
+		return strings.ContainsAny(name+arg, globSymbols)
+	}
+
+	return strings.ContainsAny(name, globSymbols)
+}
+
+func matchModelName(pattern, name string) (bool, error) {
+	if strings.HasPrefix(pattern, regexpPrefix) {
+		matched, err := regexp.MatchString(pattern, name)
+		if err != nil {
+			return false, fmt.Errorf("%w: %s: %s", ErrIncorrectPattern, pattern, err.Error())
+		}
+
+		return matched, nil
+	}
+
+	matched, err := path.Match(pattern, name)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s: %s", ErrIncorrectPattern, pattern, err.Error())
+	}
+
+	return matched, nil
+}
+
+// modelNameByTemplate replaces {Name} of template by model name like {Name}DTO
+func modelNameByTemplate(template, name string) string {
+	return strings.ReplaceAll(template, pairNamePlaceholder, name)
+}
+
+// modelDestination replaces {Name} and {name} of destination by model name or
+// creates {name}_converter.go file in destination directory
+func modelDestination(name, destination string) string {
+	if !strings.Contains(destination, pairNamePlaceholder) && !strings.Contains(destination, lowerNamePlaceholder) {
+		return generateDestination(name, destination)
+	}
+
+	destination = strings.ReplaceAll(destination, lowerNamePlaceholder, strings.ToLower(name))
+	return strings.ReplaceAll(destination, pairNamePlaceholder, name)
+}
+
+// patternOptions creates option for each source model matched by pattern of from model name.
+// Target model name is a template of from model name like {Name}DTO
+func patternOptions(lg logger.Logger, opt options.Option) ([]options.Option, error) {
+	fromStructs, err := parser.ParseModelsByPackage(lg, opt.From.Source)
+	if err != nil {
+		return nil, fmt.Errorf("parse models error: %w", err)
+	}
+
+	if opt.Getters {
+		fromStructs, err = withInterfaceModels(lg, opt.From.Source, fromStructs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	toStructs, err := parser.ParseModelsByPackage(lg, opt.To.Source)
+	if err != nil {
+		return nil, fmt.Errorf("parse models error: %w", err)
+	}
+
+	pattern, isPointer := parseModelName(opt.From.Name)
+	if strings.HasPrefix(opt.From.Name, regexpPrefix) {
+		pattern, isPointer = opt.From.Name, false
+	}
+
+	names := make([]string, 0, len(fromStructs))
+	for name := range fromStructs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var res []options.Option
+	for _, name := range names {
+		matched, err := matchModelName(pattern, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !matched {
+			continue
+		}
+
+		toName := modelNameByTemplate(opt.To.Name, name)
+		toStructName, _ := parseModelName(toName)
+		if _, ok := toStructs[toStructName]; !ok && !isMapModelName(toName) {
+			lg.Warn(fmt.Sprintf("model %s matched by %s does not have target model %s", name, opt.From.Name, toName))
+			continue
+		}
+
+		patternOpt := opt
+		patternOpt.From.Name = name
+		if isPointer {
+			patternOpt.From.Name = "*" + name
+		}
+		patternOpt.To.Name = toName
+		patternOpt.Destination = modelDestination(name, opt.Destination)
+		res = append(res, patternOpt)
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("%w: source models by pattern %s from %s", ErrNotFoundStruct, opt.From.Name, opt.From.Source)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package mapper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MatchModelName(t *testing.T) {
+	tests := []struct {
+		pattern   string
+		name      string
+		isPattern bool
+		matched   bool
+	}{
+		{pattern: "User", name: "User"},
+		{pattern: "*User", name: "User"},
+		{pattern: "User*", name: "UserSettings", isPattern: true, matched: true},
+		{pattern: "User*", name: "Order", isPattern: true},
+		{pattern: "Page[User]", name: "Page"},
+		{pattern: "*Page[*User]", name: "Page"},
+		{pattern: "map[string]any", name: "User"},
+		{pattern: "*User*", name: "UserSettings", isPattern: true, matched: true},
+		{pattern: "^(Order|Invoice)$", name: "Invoice", isPattern: true, matched: true},
+		{pattern: "^(Order|Invoice)$", name: "OrderItem", isPattern: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.isPattern, isModelPattern(tt.pattern))
+			if !tt.isPattern {
+				return
+			}
+
+			pattern, _ := parseModelName(tt.pattern)
+			if tt.pattern[0] == '^' {
+				pattern = tt.pattern
+			}
+
+			matched, err := matchModelName(pattern, tt.name)
+			require.NoError(t, err)
+			assert.Equal(t, tt.matched, matched)
+		})
+	}
+}
+
+func Test_MatchModelNameWithIncorrectPattern(t *testing.T) {
+	_, err := matchModelName("^(User$", "User")
+	require.ErrorIs(t, err, ErrIncorrectPattern)
+
+	_, err = matchModelName("User[", "User")
+	require.ErrorIs(t, err, ErrIncorrectPattern)
+}
+
+func Test_ModelDestination(t *testing.T) {
+	assert.Equal(t, "mapper/user_converter.go", modelDestination("User", "mapper/convertors.go"))
+	assert.Equal(t, "mapper/user_dto.go", modelDestination("User", "mapper/{name}_dto.go"))
+	assert.Equal(t, "mapper/User/convertor.go", modelDestination("User", "mapper/{Name}/convertor.go"))
+}
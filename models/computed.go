@@ -0,0 +1,24 @@
+package models
+
+// ComputedFunction is a user function which computes one field by some fields
+// like func([ctx context.Context,] a A, b B) (V, [error])
+type ComputedFunction struct {
+	Name        string
+	Package     Package
+	ArgTypes    []Type
+	ToType      Type
+	WithError   bool
+	WithContext bool
+}
+
+// ComputedFunctions are computed functions by function name
+type ComputedFunctions = map[string]ComputedFunction
+
+// ComputedField is a target field computed by function from some source fields
+type ComputedField struct {
+	// Field is a tag value of target field
+	Field    string
+	Function ComputedFunction
+	// Args are tag values of source fields passed to function
+	Args []string
+}
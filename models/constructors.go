@@ -0,0 +1,23 @@
+package models
+
+// Constructor is a function of model package which creates model
+// like func NewUser(id string, name string) (*User, [error])
+type Constructor struct {
+	Name   string
+	Params []Param
+	// Pointer is true if constructor returns pointer to model
+	Pointer   bool
+	WithError bool
+}
+
+// Param is a named parameter of constructor
+type Param struct {
+	Name string
+	Type Type
+}
+
+// Setter is a method of model pointer which sets one field like func (u *User) SetName(name string) [error]
+type Setter struct {
+	Name      string
+	WithError bool
+}
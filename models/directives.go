@@ -0,0 +1,21 @@
+package models
+
+// ConvertDirective is a conversion declared by model type comment like
+// DATAMAPPER convert to and from DTO:dto:json
+type ConvertDirective struct {
+	// Model is a commented model type
+	Model    Type
+	ModelTag string
+	// Other is a name of other model which can be pointer like *DTO
+	Other string
+	// OtherSource is a package of other model. It is empty if other model is in the model package
+	OtherSource string
+	OtherTag    string
+	// To is conversion from model to other model, From is conversion from other model to model
+	To   bool
+	From bool
+	// Dir is a directory of model package
+	Dir string
+	// Destination is a destination file path relative to Dir. It can be empty
+	Destination string
+}
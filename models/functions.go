@@ -14,12 +14,21 @@ type ConversionFunctionKey struct {
 }
 
 type ConversionFunction struct {
-	Name      string        `yaml:"name"`
-	Package   Package       `yaml:"package"`
-	FromType  Type          `yaml:"from_type"`
-	ToType    Type          `yaml:"to_type"`
-	TypeParam TypeParamType `yaml:"type_param"`
-	WithError bool          `yaml:"with_error"`
+	Name        string        `yaml:"name"`
+	Package     Package       `yaml:"package"`
+	FromType    Type          `yaml:"from_type"`
+	ToType      Type          `yaml:"to_type"`
+	TypeParam   TypeParamType `yaml:"type_param"`
+	WithError   bool          `yaml:"with_error"`
+	WithContext bool          `yaml:"with_context"`
+	// Lossy is true if conversion can lose data without error like truncation of numbers
+	Lossy bool `yaml:"lossy,omitempty"`
+	// Priority is used if some functions have the same types. Higher priority wins
+	Priority int `yaml:"priority,omitempty"`
+	// Inverse is a name of inverse conversion function from the same package
+	Inverse string `yaml:"inverse,omitempty"`
+	// Receiver is a call receiver of method in generated convertor like c or c.dependency
+	Receiver string `yaml:"receiver,omitempty"`
 }
 
 type Functions = map[ConversionFunctionKey]ConversionFunction
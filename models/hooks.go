@@ -0,0 +1,18 @@
+package models
+
+// Hook is a user function called by generated convertor.
+// Before hook is func(from F) [error], after hook is func(from F, to *T) [error].
+type Hook struct {
+	Name      string
+	Package   Package
+	FromType  Type
+	ToType    Type
+	WithError bool
+}
+
+// Hooks are hooks by function name
+type Hooks = map[string]Hook
+
+func (h Hook) IsAfter() bool {
+	return h.ToType.Pointer
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"go/types"
 )
 
 type KindOfType int
@@ -14,6 +15,8 @@ const (
 	SliceType
 	ArrayType
 	MapType
+	// TypeParameter is a type parameter of generic struct like T of Page[T]
+	TypeParameter
 )
 
 type Package struct {
@@ -57,6 +60,11 @@ type MapAdditional struct {
 	ValueType Type
 }
 
+// GenericAdditional is a type argument of generic struct instantiation like User of Page[User]
+type GenericAdditional struct {
+	TypeArg Type
+}
+
 type Tag struct {
 	Name  string
 	Value string
@@ -66,11 +74,26 @@ type Field struct {
 	Name string
 	Type Type
 	Tags []Tag
+	// Getter is a method name like GetName used to read field instead of field selector
+	Getter string
 }
 
 type Struct struct {
 	Type   Type
 	Fields []Field
+	// WithValidate is true if struct or pointer to it has Validate() error method
+	WithValidate bool
+	// Underlying is underlying struct type of model.
+	// Models with identical underlying types ignoring tags are convertible to each other by type conversion
+	Underlying *types.Struct
+	// TypeParams are type parameters names of generic struct. Only one type parameter is supported
+	TypeParams []string
+	// Getters are exported methods like GetName() string by names of fields returned by them
+	Getters map[string]string
+	// Constructors are functions like NewUser(...) which return struct or pointer to it
+	Constructors []Constructor
+	// Setters are methods like SetName(name string) by names of fields set by them
+	Setters map[string]Setter
 }
 
 func (t Type) FullName(basePackage string) string {
@@ -79,19 +102,24 @@ func (t Type) FullName(basePackage string) string {
 		ptr = "*"
 	}
 
+	name := t.Name
+	if additional, ok := t.Additional.(GenericAdditional); ok {
+		name = fmt.Sprintf("%s[%s]", name, additional.TypeArg.FullName(basePackage))
+	}
+
 	if t.Package.Path == basePackage {
-		return ptr + t.Name
+		return ptr + name
 	}
 
 	if t.Package.Name == "" {
-		return ptr + t.Name
+		return ptr + name
 	}
 
 	if t.Package.Alias == "" {
-		return fmt.Sprintf("%s%s.%s", ptr, t.Package.Name, t.Name)
+		return fmt.Sprintf("%s%s.%s", ptr, t.Package.Name, name)
 	}
 
-	return fmt.Sprintf("%s%s.%s", ptr, t.Package.Alias, t.Name)
+	return fmt.Sprintf("%s%s.%s", ptr, t.Package.Alias, name)
 }
 
 func (p Package) Import() string {
@@ -101,3 +129,54 @@ func (p Package) Import() string {
 
 	return fmt.Sprintf("\"%s\"", p.Path)
 }
+
+func (t *Type) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type typ Type
+	if err := unmarshal((*typ)(t)); err != nil {
+		return err
+	}
+
+	// additional info is decoded by kind to keep types comparable
+	switch t.Kind {
+	case SliceType:
+		var raw struct {
+			Additional SliceAdditional `yaml:"additional"`
+		}
+		if err := unmarshal(&raw); err != nil {
+			return err
+		}
+		t.Additional = raw.Additional
+	case ArrayType:
+		var raw struct {
+			Additional ArrayAdditional `yaml:"additional"`
+		}
+		if err := unmarshal(&raw); err != nil {
+			return err
+		}
+		t.Additional = raw.Additional
+	case MapType:
+		var raw struct {
+			Additional MapAdditional `yaml:"additional"`
+		}
+		if err := unmarshal(&raw); err != nil {
+			return err
+		}
+		t.Additional = raw.Additional
+	case StructType:
+		var raw struct {
+			Additional *GenericAdditional `yaml:"additional"`
+		}
+		if err := unmarshal(&raw); err != nil {
+			return err
+		}
+
+		t.Additional = nil
+		if raw.Additional != nil {
+			t.Additional = *raw.Additional
+		}
+	default:
+		t.Additional = nil
+	}
+
+	return nil
+}
@@ -17,7 +17,8 @@ const defaultFilePerm = 0600
 
 //nolint:lll
 type Config struct {
-	ConfigPath string `short:"c" long:"config" description:"Yaml config path" required:"false"`
+	ConfigPath string   `short:"c" long:"config" description:"Yaml config path" required:"false"`
+	ScanPaths  []string `long:"scan" description:"Models sources/packages which type comments declare conversions like // DATAMAPPER convert to DTO" required:"false"`
 	Flags
 }
 
@@ -26,6 +27,7 @@ type Flags struct {
 	Version       bool     `short:"v" long:"version" description:"Current version"`
 	Destination   string   `short:"d" long:"destination" description:"Destination file path" required:"true"`
 	UserCFSources []string `long:"cf" description:"User conversion functions sources/packages. Can add package alias like {package_path}:{alias)" required:"false"`
+	UserCFStructs []string `long:"cf-struct" description:"User conversion functions struct which methods are conversion functions like {package_path}:{struct_name}. Can add package alias like {package_path}:{struct_name}:{alias)" required:"false"`
 	FromName      string   `long:"from" description:"Model from name" required:"true"`
 	FromTag       string   `long:"from-tag" description:"Model from tag" default:"map" required:"false"`
 	FromSource    string   `long:"from-source" description:"From model source/package. Can add package alias like {package_path}:{alias)" default:"." required:"false"`
@@ -35,6 +37,19 @@ type Flags struct {
 	Inverse       bool     `short:"i" long:"inverse" description:"Create direct and inverse conversions" required:"false"`
 	Recursive     bool     `short:"r" long:"recursive" description:"Parse recursive fields and create conversion if it not exists"`
 	WithPointers  bool     `short:"p" long:"with-pointers" description:"If field is pointer and recursive flag enabled then create convertors with pointers"`
+	Checked       bool     `long:"checked" description:"Use conversions with overflow, sign loss and truncation errors for narrowing numeric and decimal fields"`
+	Convertor     string   `long:"convertor" description:"Generated convertor struct name. Convertors are generated as its methods and can use cf-struct dependencies"`
+	Mapper        string   `long:"mapper" description:"Generated interface name with all destination convertors and its default implementation"`
+	BeforeHook    string   `long:"before-hook" description:"Function name called before conversion like func(from Model) [error]. Default is Before{convertor name}"`
+	AfterHook     string   `long:"after-hook" description:"Function name called after conversion like func(from Model, to *DTO) [error]. Default is After{convertor name}"`
+	Validate      bool     `long:"validate" description:"Call Validate() error method of converted model if it exists"`
+	Computed      []string `long:"computed" description:"Target field computed by function from source fields like {to_tag_value}:{function}:{from_tag_value},{from_tag_value}" required:"false"`
+	Clone         bool     `long:"clone" description:"Generate deep copy function of from model instead of convertor"`
+	MaxChain      int      `long:"max-chain" description:"Max count of conversion functions called one by one if there is no conversion function for fields types. Chains are not used by default"`
+	Prefer        []string `long:"prefer" description:"Preferred conversion function if some conversion functions have the same types like {package}.{function}" required:"false"`
+	Getters       bool     `long:"getters" description:"Read source fields by getters like GetName() if they exist. Source model can be an interface with getters"`
+	Construct     bool     `long:"construct" description:"Create target model by its constructor like NewUser(...) and setters like SetName(...) if they exist"`
+	Constructor   string   `long:"constructor" description:"Constructor name of target model. Default is New{model name}"`
 }
 
 type Model struct {
@@ -51,16 +66,60 @@ type Option struct {
 	Destination  string `yaml:"destination"`
 	Recursive    bool   `yaml:"recursive"`
 	WithPointers bool   `yaml:"with-pointers"`
+	Checked      bool   `yaml:"checked"`
+	Convertor    string `yaml:"convertor"`
+	Mapper       string `yaml:"mapper"`
+	BeforeHook   string `yaml:"before-hook"`
+	AfterHook    string `yaml:"after-hook"`
+	Validate     bool   `yaml:"validate"`
+	// Computed are target fields computed by functions from some source fields
+	Computed []ComputedField `yaml:"computed"`
+	// Merge are other source models merged with from model into one target model
+	Merge []Model `yaml:"merge"`
+	// Clone generates deep copy function of from model instead of convertor
+	Clone bool `yaml:"clone"`
+	// MaxChain is max count of conversion functions in chain used if there is no conversion function for types
+	MaxChain int `yaml:"max-chain"`
+	// Pair generates convertors for all models of from and to sources paired by names and PairPattern
+	Pair bool `yaml:"pair"`
+	// PairPattern is a name of target model by source model name like {Name}DTO. Default is {Name}
+	PairPattern string `yaml:"pair-pattern"`
+	// Getters reads source fields by getters like GetName() if they exist
+	Getters bool `yaml:"getters"`
+	// Construct creates target model by its constructor and setters if they exist
+	Construct bool `yaml:"construct"`
+	// Constructor is a constructor name of target model. Default is New{Name}
+	Constructor string `yaml:"constructor"`
+	// ConstructorParams are tag values of target fields by constructor params names
+	ConstructorParams map[string]string `yaml:"constructor-params"`
 }
 
 type Options struct {
 	ConversionFunctions []ConversionFunction `yaml:"conversion-functions"`
-	Options             []Option             `yaml:"options"`
+	// PreferredFunctions are functions like {package}.{function} used if some functions have the same types.
+	// Package can be a name or a path of package
+	PreferredFunctions []string `yaml:"preferred-functions"`
+	Options            []Option `yaml:"options"`
+	// Scan are models sources/packages which type comments declare conversions
+	Scan []string `yaml:"scan"`
 }
 
 type ConversionFunction struct {
 	Source string `yaml:"source"`
 	Alias  string `yaml:"alias"`
+	// Struct is a type name which methods are conversion functions
+	Struct string `yaml:"struct"`
+	// Priority of functions is used if some functions have the same types. Higher priority wins
+	Priority int `yaml:"priority"`
+}
+
+type ComputedField struct {
+	// Field is a tag value of target field
+	Field string `yaml:"field"`
+	// Function is a function name from conversion functions or destination package
+	Function string `yaml:"function"`
+	// Args are tag values of source fields passed to function
+	Args []string `yaml:"args"`
 }
 
 func (m *Model) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -91,8 +150,16 @@ func parseConfig(path string) (Options, error) {
 	return opts, nil
 }
 
-func parseFlags(params Flags) (Options, error) {
-	functions := make([]ConversionFunction, 0, len(params.UserCFSources))
+func parseScanFlags(config Config) Options {
+	return Options{
+		ConversionFunctions: parseFunctionsFlags(config.Flags),
+		PreferredFunctions:  config.Prefer,
+		Scan:                config.ScanPaths,
+	}
+}
+
+func parseFunctionsFlags(params Flags) []ConversionFunction {
+	functions := make([]ConversionFunction, 0, len(params.UserCFSources)+len(params.UserCFStructs))
 	for _, opt := range params.UserCFSources {
 		source, alias := parseSourceOption(opt)
 		functions = append(functions, ConversionFunction{
@@ -101,11 +168,30 @@ func parseFlags(params Flags) (Options, error) {
 		})
 	}
 
+	for _, opt := range params.UserCFStructs {
+		source, structName, alias := parseStructOption(opt)
+		functions = append(functions, ConversionFunction{
+			Source: source,
+			Alias:  alias,
+			Struct: structName,
+		})
+	}
+
+	return functions
+}
+
+func parseFlags(params Flags) (Options, error) {
+	computed := make([]ComputedField, 0, len(params.Computed))
+	for _, opt := range params.Computed {
+		computed = append(computed, parseComputedOption(opt))
+	}
+
 	fromSource, fromAlias := parseSourceOption(params.FromSource)
 	toSource, toAlias := parseSourceOption(params.ToSource)
 
 	return Options{
-		ConversionFunctions: functions,
+		ConversionFunctions: parseFunctionsFlags(params),
+		PreferredFunctions:  params.Prefer,
 		Options: []Option{
 			{
 				Destination: params.Destination,
@@ -121,7 +207,19 @@ func parseFlags(params Flags) (Options, error) {
 					Source: toSource,
 					Alias:  toAlias,
 				},
-				Inverse: params.Inverse,
+				Inverse:     params.Inverse,
+				Checked:     params.Checked,
+				Convertor:   params.Convertor,
+				Mapper:      params.Mapper,
+				BeforeHook:  params.BeforeHook,
+				AfterHook:   params.AfterHook,
+				Validate:    params.Validate,
+				Computed:    computed,
+				Clone:       params.Clone,
+				MaxChain:    params.MaxChain,
+				Getters:     params.Getters,
+				Construct:   params.Construct,
+				Constructor: params.Constructor,
 			},
 		},
 	}, nil
@@ -143,6 +241,10 @@ func ParseOptions() (Options, error) {
 		return parseConfig(config.ConfigPath)
 	}
 
+	if len(config.ScanPaths) != 0 {
+		return parseScanFlags(config), nil
+	}
+
 	if err != nil {
 		var flagsErr *flags.Error
 		if errors.As(err, &flagsErr) || flagsErr.Type == flags.ErrHelp {
@@ -168,3 +270,30 @@ func parseSourceOption(optSource string) (string, string) {
 
 	return res[0], res[1]
 }
+
+func parseStructOption(optStruct string) (string, string, string) {
+	res := strings.SplitN(optStruct, ":", 3) //nolint:gomnd
+	for len(res) < 3 {                       //nolint:gomnd
+		res = append(res, "")
+	}
+
+	return res[0], res[1], res[2]
+}
+
+func parseComputedOption(optComputed string) ComputedField {
+	res := strings.SplitN(optComputed, ":", 3) //nolint:gomnd
+	for len(res) < 3 {                         //nolint:gomnd
+		res = append(res, "")
+	}
+
+	var args []string
+	if res[2] != "" {
+		args = strings.Split(res[2], ",")
+	}
+
+	return ComputedField{
+		Field:    res[0],
+		Function: res[1],
+		Args:     args,
+	}
+}
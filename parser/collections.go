@@ -0,0 +1,113 @@
+package parser
+
+import (
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/utils"
+)
+
+// ParseCollectionTypesByPackage parses collection types of models fields by package path or source dir
+func ParseCollectionTypesByPackage(lg logger.Logger, source string) (map[models.Type]models.Type, error) {
+	dir, err := getSourceDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCollectionTypes(lg, dir)
+}
+
+// ParseCollectionTypes parses named collection types like type Tags []string or http.Header
+// used by fields of source models. Result contains underlying slice, array or map types by named types
+func ParseCollectionTypes(lg logger.Logger, source string) (map[models.Type]models.Type, error) {
+	absSourcePath, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := utils.LoadPackage(lg, source)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := make(map[models.Type]models.Type)
+	visited := make(map[types.Type]struct{})
+	for _, name := range pkg.Types.Scope().Names() {
+		obj := pkg.Types.Scope().Lookup(name)
+
+		fset := pkg.Fset.Position(obj.Pos())
+		if !strings.Contains(fset.Filename, absSourcePath) {
+			continue
+		}
+
+		currType, ok := obj.(*types.TypeName)
+		if !ok {
+			continue
+		}
+
+		currStruct, ok := currType.Type().Underlying().(*types.Struct)
+		if !ok {
+			continue
+		}
+
+		for i := 0; i < currStruct.NumFields(); i++ {
+			err = parseCollectionTypes(currStruct.Field(i).Type(), collections, visited)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return collections, nil
+}
+
+// parseCollectionTypes walks type and collects named collection types.
+// Nested structs are skipped, because their fields are walked as models of source package
+func parseCollectionTypes(t types.Type, collections map[models.Type]models.Type,
+	visited map[types.Type]struct{}) error {
+
+	if _, ok := visited[t]; ok {
+		return nil
+	}
+	visited[t] = struct{}{}
+
+	switch t := t.(type) {
+	case *types.Alias:
+		return parseCollectionTypes(types.Unalias(t), collections, visited)
+	case *types.Pointer:
+		return parseCollectionTypes(t.Elem(), collections, visited)
+	case *types.Slice:
+		return parseCollectionTypes(t.Elem(), collections, visited)
+	case *types.Array:
+		return parseCollectionTypes(t.Elem(), collections, visited)
+	case *types.Map:
+		return parseCollectionTypes(t.Elem(), collections, visited)
+	case *types.Named:
+		switch t.Underlying().(type) {
+		case *types.Slice, *types.Array, *types.Map:
+		default:
+			return nil
+		}
+
+		named, err := parseType(t)
+		if err != nil {
+			return err
+		}
+
+		underlying, err := parseType(t.Underlying())
+		if err != nil {
+			return err
+		}
+
+		if len(named) == 1 && len(underlying) == 1 {
+			collections[named[0].Type] = underlying[0].Type
+		}
+
+		return parseCollectionTypes(t.Underlying(), collections, visited)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_ParseCollectionTypes(t *testing.T) {
+	treePackage := models.Package{Name: "tree", Path: "github.com/underbek/datamapper/_test_data/mapper/tree"}
+	stringType := models.Type{Name: "string", Kind: models.BaseType}
+
+	collections, err := ParseCollectionTypes(logger.New(), "../_test_data/mapper/tree")
+	require.NoError(t, err)
+
+	expected := map[models.Type]models.Type{
+		{Name: "Tags", Package: treePackage, Kind: models.RedefinedType}: {
+			Kind:       models.SliceType,
+			Additional: models.SliceAdditional{InType: stringType},
+		},
+		{Name: "Nodes", Package: treePackage, Kind: models.RedefinedType}: {
+			Kind: models.SliceType,
+			Additional: models.SliceAdditional{
+				InType: models.Type{Name: "Node", Package: treePackage, Pointer: true, Kind: models.StructType},
+			},
+		},
+		{Name: "Attributes", Package: treePackage, Kind: models.RedefinedType}: {
+			Kind: models.MapType,
+			Additional: models.MapAdditional{
+				KeyType:   stringType,
+				ValueType: models.Type{Name: "Option", Package: treePackage, Kind: models.StructType},
+			},
+		},
+		{Name: "Header", Package: models.Package{Name: "http", Path: "net/http"}, Kind: models.RedefinedType}: {
+			Kind: models.MapType,
+			Additional: models.MapAdditional{
+				KeyType: stringType,
+				ValueType: models.Type{
+					Kind:       models.SliceType,
+					Additional: models.SliceAdditional{InType: stringType},
+				},
+			},
+		},
+		{Name: "IP", Package: models.Package{Name: "net", Path: "net"}, Kind: models.RedefinedType}: {
+			Kind:       models.SliceType,
+			Additional: models.SliceAdditional{InType: models.Type{Name: "byte", Kind: models.BaseType}},
+		},
+	}
+
+	assert.Equal(t, expected, collections)
+}
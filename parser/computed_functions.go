@@ -0,0 +1,149 @@
+package parser
+
+import (
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/utils"
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	computedFunctionsCache = make(map[string]models.ComputedFunctions)
+)
+
+func ParseComputedFunctionsByPackage(lg logger.Logger, source string) (models.ComputedFunctions, error) {
+	dir, err := getSourceDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseComputedFunctions(lg, dir)
+}
+
+// ParseComputedFunctions parses non-generic functions which can compute field by some fields
+func ParseComputedFunctions(lg logger.Logger, source string) (models.ComputedFunctions, error) {
+	absSourcePath, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if funcs, ok := computedFunctionsCache[absSourcePath]; ok {
+		return funcs, nil
+	}
+
+	pkg, err := utils.LoadPackage(lg, source)
+	if err != nil {
+		return nil, err
+	}
+
+	funcs := make(models.ComputedFunctions)
+	if pkg.Types == nil {
+		return funcs, nil
+	}
+
+	names := pkg.Types.Scope().Names()
+	for _, name := range names {
+		obj := pkg.Types.Scope().Lookup(name)
+
+		fset := pkg.Fset.Position(obj.Pos())
+		if !strings.Contains(fset.Filename, absSourcePath) {
+			continue
+		}
+
+		f, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+
+		if !f.Exported() {
+			continue
+		}
+
+		function, ok, err := parseComputedFunction(pkg, f)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			funcs[function.Name] = function
+		}
+	}
+
+	computedFunctionsCache[absSourcePath] = funcs
+
+	return funcs, nil
+}
+
+func parseComputedFunction(pkg *packages.Package, f *types.Func) (models.ComputedFunction, bool, error) {
+	signature, ok := f.Type().(*types.Signature)
+	if !ok || signature.TypeParams().Len() != 0 {
+		return models.ComputedFunction{}, false, nil
+	}
+
+	if signature.Results().Len() == 0 || signature.Results().Len() > 2 {
+		return models.ComputedFunction{}, false, nil
+	}
+
+	fromIndex := 0
+	withContext := false
+	if signature.Params().Len() != 0 && isContextType(signature.Params().At(0).Type()) {
+		fromIndex = 1
+		withContext = true
+	}
+
+	if signature.Params().Len() <= fromIndex {
+		return models.ComputedFunction{}, false, nil
+	}
+
+	argTypes := make([]models.Type, 0, signature.Params().Len()-fromIndex)
+	for i := fromIndex; i < signature.Params().Len(); i++ {
+		argType, ok := parseSingleType(signature.Params().At(i).Type())
+		if !ok {
+			return models.ComputedFunction{}, false, nil
+		}
+
+		argTypes = append(argTypes, argType)
+	}
+
+	toType, ok := parseSingleType(signature.Results().At(0).Type())
+	if !ok {
+		return models.ComputedFunction{}, false, nil
+	}
+
+	withError := false
+	if signature.Results().Len() == 2 { //nolint:gomnd
+		// unsupported result types are skipped
+		isError, err := isErrorType(signature.Results().At(1).Type())
+		if err != nil || !isError {
+			return models.ComputedFunction{}, false, nil
+		}
+
+		withError = true
+	}
+
+	return models.ComputedFunction{
+		Name: f.Name(),
+		Package: models.Package{
+			Name: pkg.Name,
+			Path: pkg.PkgPath,
+		},
+		ArgTypes:    argTypes,
+		ToType:      toType,
+		WithError:   withError,
+		WithContext: withContext,
+	}, true, nil
+}
+
+// parseSingleType parses type which is not generic constraint. Unsupported types are skipped
+func parseSingleType(t types.Type) (models.Type, bool) {
+	res, err := parseType(t)
+	if err != nil || len(res) != 1 || res[0].generic {
+		return models.Type{}, false
+	}
+
+	return res[0].Type, true
+}
@@ -0,0 +1,39 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_ParseComputedFunctions(t *testing.T) {
+	res, err := ParseComputedFunctionsByPackage(logger.New(), "../_test_data/mapper/computed")
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	pkg := models.Package{
+		Name: "computed",
+		Path: "github.com/underbek/datamapper/_test_data/mapper/computed",
+	}
+
+	stringType := models.Type{Name: "string"}
+	int64Type := models.Type{Name: "int64"}
+
+	assert.Equal(t, models.ComputedFunction{
+		Name:     "FullName",
+		Package:  pkg,
+		ArgTypes: []models.Type{stringType, stringType},
+		ToType:   stringType,
+	}, res["FullName"])
+
+	assert.Equal(t, models.ComputedFunction{
+		Name:      "NewMoney",
+		Package:   pkg,
+		ArgTypes:  []models.Type{int64Type, stringType},
+		ToType:    models.Type{Name: "Money", Package: pkg, Kind: models.StructType},
+		WithError: true,
+	}, res["NewMoney"])
+}
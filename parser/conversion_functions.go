@@ -3,9 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
-	"go/build"
 	"go/types"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -26,26 +24,12 @@ var (
 )
 
 func ParseConversionFunctionsByPackage(lg logger.Logger, source string) (models.Functions, error) {
-	_, err := os.Stat(source)
-	if err == nil {
-		return ParseConversionFunctions(lg, source)
-	}
-
-	if !os.IsNotExist(err) {
-		return nil, err
-	}
-
-	wd, err := os.Getwd()
+	dir, err := getSourceDir(source)
 	if err != nil {
 		return nil, err
 	}
 
-	p, err := build.Import(source, wd, build.FindOnly)
-	if err != nil {
-		return nil, err
-	}
-
-	return ParseConversionFunctions(lg, p.Dir)
+	return ParseConversionFunctions(lg, dir)
 }
 
 func ParseConversionFunctions(lg logger.Logger, source string) (models.Functions, error) {
@@ -108,7 +92,28 @@ func parseFunction(pkg *packages.Package, f *types.Func) (models.Functions, erro
 		return nil, fmt.Errorf("%w: function %s hasn't signature", ErrNotFoundSign, f.Name())
 	}
 
-	if signature.Params().Len() != 1 {
+	directives, err := parseFunctionDirectives(pkg, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if directives.ignore {
+		return nil, nil
+	}
+
+	// conversion function can have context as first param
+	fromIndex := 0
+	withContext := false
+	switch signature.Params().Len() {
+	case 1:
+	case 2: //nolint:gomnd
+		if !isContextType(signature.Params().At(0).Type()) {
+			return nil, nil
+		}
+
+		fromIndex = 1
+		withContext = true
+	default:
 		return nil, nil
 	}
 
@@ -116,7 +121,7 @@ func parseFunction(pkg *packages.Package, f *types.Func) (models.Functions, erro
 		return nil, nil
 	}
 
-	if signature.Params().At(0).Type() == nil {
+	if signature.Params().At(fromIndex).Type() == nil {
 		return nil, nil
 	}
 
@@ -124,7 +129,7 @@ func parseFunction(pkg *packages.Package, f *types.Func) (models.Functions, erro
 		return nil, nil
 	}
 
-	fromTypes, err := parseType(signature.Params().At(0).Type())
+	fromTypes, err := parseType(signature.Params().At(fromIndex).Type())
 	if err != nil {
 		return nil, err
 	}
@@ -162,10 +167,14 @@ func parseFunction(pkg *packages.Package, f *types.Func) (models.Functions, erro
 					Name: pkg.Name,
 					Path: pkg.PkgPath,
 				},
-				FromType:  fromType.Type,
-				ToType:    toType.Type,
-				TypeParam: getTypeParam(fromType.generic, toType.generic),
-				WithError: withError,
+				FromType:    fromType.Type,
+				ToType:      toType.Type,
+				TypeParam:   getTypeParam(fromType.generic, toType.generic),
+				WithError:   withError,
+				WithContext: withContext,
+				Lossy:       directives.lossy,
+				Priority:    directives.priority,
+				Inverse:     directives.inverse,
 			}
 
 			funcs[key] = cv
@@ -393,6 +393,115 @@ func Test_CFParseWithError(t *testing.T) {
 	}
 }
 
+func Test_CFParseWithContext(t *testing.T) {
+	res, err := ParseConversionFunctions(logger.New(), testPath+"with_context.go")
+	require.NoError(t, err)
+	assert.Len(t, res, 2)
+
+	pkg := models.Package{
+		Name: "parser",
+		Path: "github.com/underbek/datamapper/_test_data/parser",
+	}
+
+	assert.Equal(t,
+		models.ConversionFunction{
+			Name:        "ConvertStringToIntWithContext",
+			Package:     pkg,
+			FromType:    models.Type{Name: "string"},
+			ToType:      models.Type{Name: "int"},
+			WithError:   true,
+			WithContext: true,
+		},
+		res[models.ConversionFunctionKey{FromType: models.Type{Name: "string"}, ToType: models.Type{Name: "int"}}],
+	)
+
+	assert.Equal(t,
+		models.ConversionFunction{
+			Name:        "ConvertFloatToStringWithContext",
+			Package:     pkg,
+			FromType:    models.Type{Name: "float64"},
+			ToType:      models.Type{Name: "string"},
+			WithContext: true,
+		},
+		res[models.ConversionFunctionKey{FromType: models.Type{Name: "float64"}, ToType: models.Type{Name: "string"}}],
+	)
+}
+
+func Test_CFParseWithDirectives(t *testing.T) {
+	res, err := ParseConversionFunctions(logger.New(), testPath+"with_directives.go")
+	require.NoError(t, err)
+	assert.Len(t, res, 2)
+
+	pkg := models.Package{
+		Name: "parser",
+		Path: "github.com/underbek/datamapper/_test_data/parser",
+	}
+
+	assert.Equal(t,
+		models.ConversionFunction{
+			Name:     "ConvertFloat64ToString",
+			Package:  pkg,
+			FromType: models.Type{Name: "float64"},
+			ToType:   models.Type{Name: "string"},
+			Priority: 10,
+			Inverse:  "ConvertStringToFloat64",
+		},
+		res[models.ConversionFunctionKey{FromType: models.Type{Name: "float64"}, ToType: models.Type{Name: "string"}}],
+	)
+
+	assert.Equal(t,
+		models.ConversionFunction{
+			Name:      "ConvertStringToFloat64",
+			Package:   pkg,
+			FromType:  models.Type{Name: "string"},
+			ToType:    models.Type{Name: "float64"},
+			WithError: true,
+			Lossy:     true,
+			Inverse:   "ConvertFloat64ToString",
+		},
+		res[models.ConversionFunctionKey{FromType: models.Type{Name: "string"}, ToType: models.Type{Name: "float64"}}],
+	)
+}
+
+func Test_CFParseMethods(t *testing.T) {
+	receiver, res, err := ParseConversionMethodsByPackage(
+		logger.New(),
+		"../_test_data/mapper/dependencies",
+		"Rates",
+	)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	pkg := models.Package{
+		Name: "dependencies",
+		Path: "github.com/underbek/datamapper/_test_data/mapper/dependencies",
+	}
+
+	assert.Equal(t, models.Type{Name: "Rates", Package: pkg, Pointer: true, Kind: models.StructType}, receiver)
+
+	decimalType := models.Type{
+		Name: "Decimal",
+		Package: models.Package{
+			Name: "decimal",
+			Path: "github.com/shopspring/decimal",
+		},
+		Kind: models.StructType,
+	}
+
+	assert.Equal(t,
+		models.ConversionFunction{
+			Name:     "ConvertDecimalToString",
+			Package:  pkg,
+			FromType: decimalType,
+			ToType:   models.Type{Name: "string"},
+		},
+		res[models.ConversionFunctionKey{FromType: decimalType, ToType: models.Type{Name: "string"}}],
+	)
+
+	_, _, err = ParseConversionMethodsByPackage(logger.New(), "../_test_data/mapper/dependencies", "Incorrect")
+	require.ErrorIs(t, err, ErrNotFoundReceiver)
+}
+
 func Test_CFParseWithPointers(t *testing.T) {
 	res, err := ParseConversionFunctions(logger.New(), testPath+"with_pointers.go")
 	require.NoError(t, err)
@@ -553,13 +662,15 @@ func Test_CFParseByDefaultPackage(t *testing.T) {
 	lg := logger.New()
 	cf, err := ParseConversionFunctionsByPackage(lg, internalConvertsPackagePath)
 	require.NoError(t, err)
-	require.Len(t, cf, 219)
+	require.Len(t, cf, 275)
 
 	embedCf, err := loader.Read()
 	require.NoError(t, err)
-	require.Len(t, embedCf, 219)
+	require.Len(t, embedCf, 275)
 
 	for key, value := range cf {
+		// lossy flag of built-in functions is set by loader
+		value.Lossy = value.Lossy || embedCf[key].Lossy
 		require.Equal(t, value, embedCf[key])
 	}
 }
@@ -0,0 +1,83 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"go/types"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/utils"
+)
+
+var ErrNotFoundReceiver = errors.New("not found receiver type error")
+
+// ParseConversionMethodsByPackage parses exported methods of named type as conversion functions.
+// Returns type of receiver: pointer to struct or interface. Receiver of functions must be set by caller.
+func ParseConversionMethodsByPackage(lg logger.Logger, source, typeName string) (models.Type, models.Functions, error) {
+	dir, err := getSourceDir(source)
+	if err != nil {
+		return models.Type{}, nil, err
+	}
+
+	return ParseConversionMethods(lg, dir, typeName)
+}
+
+func ParseConversionMethods(lg logger.Logger, source, typeName string) (models.Type, models.Functions, error) {
+	pkg, err := utils.LoadPackage(lg, source)
+	if err != nil {
+		return models.Type{}, nil, err
+	}
+
+	if pkg.Types == nil {
+		return models.Type{}, nil, fmt.Errorf("%w: package %s hasn't type", ErrNotFoundType, pkg.Name)
+	}
+
+	obj, ok := pkg.Types.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return models.Type{}, nil, fmt.Errorf("%w: %s in %s", ErrNotFoundReceiver, typeName, source)
+	}
+
+	named, ok := obj.Type().(*types.Named)
+	if !ok {
+		return models.Type{}, nil, fmt.Errorf("%w: %s in %s", ErrNotFoundReceiver, typeName, source)
+	}
+
+	receiver := models.Type{
+		Name: named.Obj().Name(),
+		Package: models.Package{
+			Name: pkg.Name,
+			Path: pkg.PkgPath,
+		},
+		Kind: models.StructType,
+	}
+
+	var methodSetType types.Type = types.NewPointer(named)
+	if types.IsInterface(named) {
+		methodSetType = named
+		receiver.Kind = models.InterfaceType
+	} else {
+		receiver.Pointer = true
+	}
+
+	funcs := make(models.Functions)
+
+	methods := types.NewMethodSet(methodSetType)
+	for i := 0; i < methods.Len(); i++ {
+		f, ok := methods.At(i).Obj().(*types.Func)
+		if !ok || !f.Exported() {
+			continue
+		}
+
+		currentFuncs, err := parseFunction(pkg, f)
+		if err != nil {
+			return models.Type{}, nil, err
+		}
+
+		for key, function := range currentFuncs {
+			funcs[key] = function
+		}
+	}
+
+	return receiver, funcs, nil
+}
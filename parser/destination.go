@@ -3,6 +3,7 @@ package parser
 import (
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/underbek/datamapper/logger"
@@ -22,6 +23,36 @@ func ParseDestinationPackage(lg logger.Logger, destination string) (models.Packa
 	return generateModelPackage(pkg)
 }
 
+// ParseDestinationNames parses names declared in package of destination except names of destination file,
+// because destination file is generated again
+func ParseDestinationNames(lg logger.Logger, destination string) (map[string]struct{}, error) {
+	absDestination, err := filepath.Abs(destination)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := utils.LoadPackage(lg, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]struct{})
+	if pkg.Types == nil {
+		return names, nil
+	}
+
+	for _, name := range pkg.Types.Scope().Names() {
+		obj := pkg.Types.Scope().Lookup(name)
+		if pkg.Fset.Position(obj.Pos()).Filename == absDestination {
+			continue
+		}
+
+		names[name] = struct{}{}
+	}
+
+	return names, nil
+}
+
 func generateModelPackage(pkg *packages.Package) (models.Package, error) {
 	if pkg.Name != "" {
 		return models.Package{
@@ -0,0 +1,95 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const directivePrefix = "//datamapper:"
+
+const (
+	ignoreDirective   = "ignore"
+	priorityDirective = "priority"
+	lossyDirective    = "lossy"
+	inverseDirective  = "inverse"
+)
+
+var ErrIncorrectDirective = errors.New("incorrect directive error")
+
+// functionDirectives are conversion function metadata from doc comment directives like //datamapper:lossy
+type functionDirectives struct {
+	ignore   bool
+	priority int
+	lossy    bool
+	inverse  string
+}
+
+// parseFunctionDirectives parses doc comment directives of function declaration:
+//
+//	//datamapper:ignore - function is not a conversion function
+//	//datamapper:priority 10 - priority of function if some functions have the same types
+//	//datamapper:lossy - conversion can lose data
+//	//datamapper:inverse ConvertStringToMoney - inverse conversion function name
+func parseFunctionDirectives(pkg *packages.Package, f *types.Func) (functionDirectives, error) {
+	var res functionDirectives
+
+	decl := findFuncDecl(pkg, f)
+	if decl == nil || decl.Doc == nil {
+		return res, nil
+	}
+
+	for _, comment := range decl.Doc.List {
+		if !strings.HasPrefix(comment.Text, directivePrefix) {
+			continue
+		}
+
+		name, arg, _ := strings.Cut(strings.TrimPrefix(comment.Text, directivePrefix), " ")
+		arg = strings.TrimSpace(arg)
+
+		switch name {
+		case ignoreDirective:
+			res.ignore = true
+		case lossyDirective:
+			res.lossy = true
+		case priorityDirective:
+			priority, err := strconv.Atoi(arg)
+			if err != nil {
+				return functionDirectives{}, fmt.Errorf("%w: function %s has incorrect priority %q",
+					ErrIncorrectDirective, f.Name(), arg)
+			}
+
+			res.priority = priority
+		case inverseDirective:
+			if arg == "" {
+				return functionDirectives{}, fmt.Errorf("%w: function %s has empty inverse function name",
+					ErrIncorrectDirective, f.Name())
+			}
+
+			res.inverse = arg
+		default:
+			return functionDirectives{}, fmt.Errorf("%w: function %s has unknown directive %s",
+				ErrIncorrectDirective, f.Name(), name)
+		}
+	}
+
+	return res, nil
+}
+
+func findFuncDecl(pkg *packages.Package, f *types.Func) *ast.FuncDecl {
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if ok && funcDecl.Name.Pos() == f.Pos() {
+				return funcDecl
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package parser
+
+import (
+	"go/types"
+	"path/filepath"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/utils"
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	hooksCache = make(map[string]models.Hooks)
+)
+
+func ParseHooksByPackage(lg logger.Logger, source string) (models.Hooks, error) {
+	dir, err := getSourceDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseHooks(lg, dir)
+}
+
+// ParseHooks parses functions with hook signatures: func(from F) [error] or func(from F, to *T) [error]
+func ParseHooks(lg logger.Logger, source string) (models.Hooks, error) {
+	absSourcePath, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if hooks, ok := hooksCache[absSourcePath]; ok {
+		return hooks, nil
+	}
+
+	pkg, err := utils.LoadPackage(lg, source)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := make(models.Hooks)
+	if pkg.Types == nil {
+		return hooks, nil
+	}
+
+	names := pkg.Types.Scope().Names()
+	for _, name := range names {
+		obj := pkg.Types.Scope().Lookup(name)
+
+		fset := pkg.Fset.Position(obj.Pos())
+		if !strings.Contains(fset.Filename, absSourcePath) {
+			continue
+		}
+
+		f, ok := obj.(*types.Func)
+		if !ok {
+			continue
+		}
+
+		if !f.Exported() {
+			continue
+		}
+
+		hook, ok, err := parseHook(pkg, f)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			hooks[hook.Name] = hook
+		}
+	}
+
+	hooksCache[absSourcePath] = hooks
+
+	return hooks, nil
+}
+
+func parseHook(pkg *packages.Package, f *types.Func) (models.Hook, bool, error) {
+	signature, ok := f.Type().(*types.Signature)
+	if !ok || signature.TypeParams().Len() != 0 {
+		return models.Hook{}, false, nil
+	}
+
+	if signature.Params().Len() == 0 || signature.Params().Len() > 2 {
+		return models.Hook{}, false, nil
+	}
+
+	withError := false
+	switch signature.Results().Len() {
+	case 0:
+	case 1:
+		// unsupported result types are skipped
+		isError, err := isErrorType(signature.Results().At(0).Type())
+		if err != nil || !isError {
+			return models.Hook{}, false, nil
+		}
+
+		withError = true
+	default:
+		return models.Hook{}, false, nil
+	}
+
+	fromType, ok := parseHookType(signature.Params().At(0).Type())
+	if !ok {
+		return models.Hook{}, false, nil
+	}
+
+	hook := models.Hook{
+		Name: f.Name(),
+		Package: models.Package{
+			Name: pkg.Name,
+			Path: pkg.PkgPath,
+		},
+		FromType:  fromType,
+		WithError: withError,
+	}
+
+	if signature.Params().Len() == 1 {
+		return hook, true, nil
+	}
+
+	toType, ok := parseHookType(signature.Params().At(1).Type())
+	if !ok || !toType.Pointer {
+		return models.Hook{}, false, nil
+	}
+
+	hook.ToType = toType
+
+	return hook, true, nil
+}
+
+func parseHookType(t types.Type) (models.Type, bool) {
+	res, ok := parseSingleType(t)
+	if !ok || res.Kind != models.StructType {
+		return models.Type{}, false
+	}
+
+	return res, true
+}
@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_ParseHooks(t *testing.T) {
+	res, err := ParseHooksByPackage(logger.New(), "../_test_data/mapper/hooks")
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	pkg := models.Package{
+		Name: "hooks",
+		Path: "github.com/underbek/datamapper/_test_data/mapper/hooks",
+	}
+
+	domainUser := models.Type{
+		Name: "User",
+		Package: models.Package{
+			Name: "domain",
+			Path: "github.com/underbek/datamapper/_test_data/mapper/domain",
+		},
+		Kind: models.StructType,
+	}
+
+	transportUser := models.Type{
+		Name: "User",
+		Package: models.Package{
+			Name: "transport",
+			Path: "github.com/underbek/datamapper/_test_data/mapper/transport",
+		},
+		Kind: models.StructType,
+	}
+
+	before := res["BeforeConvertTransportUserToDomainUser"]
+	assert.Equal(t, models.Hook{
+		Name:      "BeforeConvertTransportUserToDomainUser",
+		Package:   pkg,
+		FromType:  transportUser,
+		WithError: true,
+	}, before)
+	assert.False(t, before.IsAfter())
+
+	after := res["AfterConvertDomainUserToTransportUser"]
+	transportUser.Pointer = true
+	assert.Equal(t, models.Hook{
+		Name:     "AfterConvertDomainUserToTransportUser",
+		Package:  pkg,
+		FromType: domainUser,
+		ToType:   transportUser,
+	}, after)
+	assert.True(t, after.IsAfter())
+
+	assert.True(t, res["NormalizeUserName"].WithError)
+}
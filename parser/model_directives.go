@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+	"github.com/underbek/datamapper/utils"
+)
+
+const (
+	modelDirectivePrefix = "DATAMAPPER"
+	convertDirective     = "convert"
+	defaultTag           = "map"
+)
+
+func ParseConvertDirectivesByPackage(lg logger.Logger, source string) ([]models.ConvertDirective, error) {
+	dir, err := getSourceDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseConvertDirectives(lg, dir)
+}
+
+// ParseConvertDirectives parses conversions declared by comments of model types like
+//
+//	// DATAMAPPER convert {from|to|to and from} [{other_package}.]{Other}[:{model_tag}[:{other_tag}]] [{destination}]
+func ParseConvertDirectives(lg logger.Logger, source string) ([]models.ConvertDirective, error) {
+	absSourcePath, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := utils.LoadPackage(lg, source)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.ConvertDirective
+	for _, file := range pkg.Syntax {
+		if !strings.Contains(pkg.Fset.Position(file.Pos()).Filename, absSourcePath) {
+			continue
+		}
+
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+
+			for _, spec := range genDecl.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok {
+					continue
+				}
+
+				// comment of single type declaration belongs to declaration
+				doc := typeSpec.Doc
+				if doc == nil && len(genDecl.Specs) == 1 {
+					doc = genDecl.Doc
+				}
+
+				if doc == nil {
+					continue
+				}
+
+				model := models.Type{
+					Name: typeSpec.Name.Name,
+					Package: models.Package{
+						Name: pkg.Name,
+						Path: pkg.PkgPath,
+					},
+					Kind: models.StructType,
+				}
+
+				for _, comment := range doc.List {
+					text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+					if !strings.HasPrefix(text, modelDirectivePrefix+" ") {
+						continue
+					}
+
+					directive, err := parseConvertDirective(text, model)
+					if err != nil {
+						return nil, err
+					}
+
+					directive.Dir = utils.ClearFileName(source)
+					res = append(res, directive)
+				}
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func parseConvertDirective(text string, model models.Type) (models.ConvertDirective, error) {
+	fields := strings.Fields(strings.TrimPrefix(text, modelDirectivePrefix))
+	if len(fields) < 3 || fields[0] != convertDirective { //nolint:gomnd
+		return models.ConvertDirective{}, fmt.Errorf("%w: model %s has incorrect directive %q",
+			ErrIncorrectDirective, model.Name, text)
+	}
+
+	directive := models.ConvertDirective{
+		Model: model,
+	}
+
+	fields = fields[1:]
+	switch {
+	case fields[1] == "and":
+		if len(fields) < 4 || //nolint:gomnd
+			!(fields[0] == "to" && fields[2] == "from" || fields[0] == "from" && fields[2] == "to") {
+			return models.ConvertDirective{}, fmt.Errorf("%w: model %s has incorrect direction in directive %q",
+				ErrIncorrectDirective, model.Name, text)
+		}
+
+		directive.To = true
+		directive.From = true
+		fields = fields[3:]
+	case fields[0] == "to":
+		directive.To = true
+		fields = fields[1:]
+	case fields[0] == "from":
+		directive.From = true
+		fields = fields[1:]
+	default:
+		return models.ConvertDirective{}, fmt.Errorf("%w: model %s has incorrect direction in directive %q",
+			ErrIncorrectDirective, model.Name, text)
+	}
+
+	if len(fields) == 0 || len(fields) > 2 { //nolint:gomnd
+		return models.ConvertDirective{}, fmt.Errorf("%w: model %s has incorrect model in directive %q",
+			ErrIncorrectDirective, model.Name, text)
+	}
+
+	if len(fields) == 2 { //nolint:gomnd
+		directive.Destination = fields[1]
+	}
+
+	spec := strings.SplitN(fields[0], ":", 3) //nolint:gomnd
+	for len(spec) < 3 {                       //nolint:gomnd
+		spec = append(spec, defaultTag)
+	}
+
+	directive.Other = spec[0]
+	directive.ModelTag = spec[1]
+	directive.OtherTag = spec[2]
+
+	pointer := strings.HasPrefix(directive.Other, "*")
+	directive.Other = strings.TrimPrefix(directive.Other, "*")
+	if i := strings.LastIndex(directive.Other, "."); i != -1 {
+		directive.OtherSource = directive.Other[:i]
+		directive.Other = directive.Other[i+1:]
+	}
+
+	if pointer {
+		directive.Other = "*" + directive.Other
+	}
+
+	return directive, nil
+}
@@ -0,0 +1,120 @@
+package parser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_ParseConvertDirectives(t *testing.T) {
+	const source = "../_test_data/mapper/comments"
+
+	res, err := ParseConvertDirectivesByPackage(logger.New(), source)
+	require.NoError(t, err)
+
+	model := models.Type{
+		Name: "Model",
+		Package: models.Package{
+			Name: "comments",
+			Path: "github.com/underbek/datamapper/_test_data/mapper/comments",
+		},
+		Kind: models.StructType,
+	}
+
+	assert.Equal(t, []models.ConvertDirective{
+		{
+			Model:       model,
+			ModelTag:    "dto",
+			Other:       "DTO",
+			OtherTag:    "json",
+			From:        true,
+			Dir:         source,
+			Destination: "../../generated/mapper/dto_convertor.go",
+		},
+		{
+			Model:       model,
+			ModelTag:    "dao",
+			Other:       "*DAO",
+			OtherTag:    "db",
+			To:          true,
+			From:        true,
+			Dir:         source,
+			Destination: "../../generated/mapper/dao_convertor.go",
+		},
+	}, res)
+}
+
+func Test_ParseConvertDirective(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected models.ConvertDirective
+		err      error
+	}{
+		{
+			name: "Default tags",
+			text: "DATAMAPPER convert to DTO",
+			expected: models.ConvertDirective{
+				ModelTag: defaultTag,
+				Other:    "DTO",
+				OtherTag: defaultTag,
+				To:       true,
+			},
+		},
+		{
+			name: "Other package",
+			text: "DATAMAPPER convert from and to *github.com/org/dto.User:map:json",
+			expected: models.ConvertDirective{
+				ModelTag:    defaultTag,
+				Other:       "*User",
+				OtherSource: "github.com/org/dto",
+				OtherTag:    "json",
+				To:          true,
+				From:        true,
+			},
+		},
+		{
+			name: "Relative package",
+			text: "DATAMAPPER convert from ../dto.User:map user.go",
+			expected: models.ConvertDirective{
+				ModelTag:    defaultTag,
+				Other:       "User",
+				OtherSource: "../dto",
+				OtherTag:    defaultTag,
+				From:        true,
+				Destination: "user.go",
+			},
+		},
+		{
+			name: "Unknown command",
+			text: "DATAMAPPER copy to DTO",
+			err:  ErrIncorrectDirective,
+		},
+		{
+			name: "Unknown direction",
+			text: "DATAMAPPER convert into DTO",
+			err:  ErrIncorrectDirective,
+		},
+		{
+			name: "Without model",
+			text: "DATAMAPPER convert to and from",
+			err:  ErrIncorrectDirective,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := parseConvertDirective(tt.text, models.Type{})
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, res)
+		})
+	}
+}
@@ -1,9 +1,7 @@
 package parser
 
 import (
-	"go/build"
 	"go/types"
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -12,31 +10,23 @@ import (
 	"github.com/underbek/datamapper/utils"
 )
 
+const (
+	getterPrefix      = "Get"
+	setterPrefix      = "Set"
+	constructorPrefix = "New"
+)
+
 var (
 	modelsCache = make(map[string]map[string]models.Struct)
 )
 
 func ParseModelsByPackage(lg logger.Logger, source string) (map[string]models.Struct, error) {
-	_, err := os.Stat(source)
-	if err == nil {
-		return ParseModels(lg, source)
-	}
-
-	if !os.IsNotExist(err) {
-		return nil, err
-	}
-
-	wd, err := os.Getwd()
-	if err != nil {
-		return nil, err
-	}
-
-	p, err := build.Import(source, wd, build.FindOnly)
+	dir, err := getSourceDir(source)
 	if err != nil {
 		return nil, err
 	}
 
-	return ParseModels(lg, p.Dir)
+	return ParseModels(lg, dir)
 }
 
 func ParseModels(lg logger.Logger, source string) (map[string]models.Struct, error) {
@@ -83,10 +73,15 @@ func ParseModels(lg logger.Logger, source string) (map[string]models.Struct, err
 			continue
 		}
 
+		typeParams, ok := parseTypeParams(currType.Type())
+		if !ok {
+			continue
+		}
+
 		fields := make([]models.Field, 0, currStruct.NumFields())
 		for i := 0; i < currStruct.NumFields(); i++ {
 			field := currStruct.Field(i)
-			tts, err := parseType(field.Type())
+			tts, err := parseFieldType(field.Type())
 			if err != nil {
 				return nil, err
 			}
@@ -111,11 +106,308 @@ func ParseModels(lg logger.Logger, source string) (map[string]models.Struct, err
 				},
 				Kind: models.StructType,
 			},
-			Fields: fields,
+			Fields:       fields,
+			WithValidate: hasValidateMethod(currType.Type()),
+			Underlying:   currStruct,
+			TypeParams:   typeParams,
+			Getters:      parseGetters(currType.Type(), currStruct),
+			Setters:      parseSetters(currType.Type(), currStruct),
+		}
+	}
+
+	for _, name := range names {
+		obj := pkg.Types.Scope().Lookup(name)
+
+		fset := pkg.Fset.Position(obj.Pos())
+		if !strings.Contains(fset.Filename, absSourcePath) {
+			continue
+		}
+
+		function, ok := obj.(*types.Func)
+		if !ok || !function.Exported() || !strings.HasPrefix(function.Name(), constructorPrefix) {
+			continue
+		}
+
+		modelName, constructor, ok := parseConstructor(function)
+		if !ok {
+			continue
 		}
+
+		model, ok := structs[modelName]
+		if !ok || model.Type.Kind != models.StructType || len(model.TypeParams) != 0 {
+			continue
+		}
+
+		model.Constructors = append(model.Constructors, constructor)
+		structs[modelName] = model
 	}
 
 	modelsCache[absSourcePath] = structs
 
 	return structs, nil
 }
+
+// parseGetters finds exported methods like GetName() string which return struct fields
+func parseGetters(t types.Type, s *types.Struct) map[string]string {
+	methods := types.NewMethodSet(types.NewPointer(t))
+
+	res := make(map[string]string)
+	for i := 0; i < s.NumFields(); i++ {
+		field := s.Field(i)
+		name := getterPrefix + strings.ToUpper(field.Name()[:1]) + field.Name()[1:]
+
+		sel := methods.Lookup(nil, name)
+		if sel == nil {
+			continue
+		}
+
+		result, ok := getterResult(sel.Obj())
+		if ok && types.Identical(result, field.Type()) {
+			res[field.Name()] = name
+		}
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+// parseSetters finds exported methods like SetName(name string) [error] which set struct fields
+func parseSetters(t types.Type, s *types.Struct) map[string]models.Setter {
+	methods := types.NewMethodSet(types.NewPointer(t))
+
+	res := make(map[string]models.Setter)
+	for i := 0; i < s.NumFields(); i++ {
+		field := s.Field(i)
+		name := setterPrefix + strings.ToUpper(field.Name()[:1]) + field.Name()[1:]
+
+		sel := methods.Lookup(nil, name)
+		if sel == nil {
+			continue
+		}
+
+		signature, ok := sel.Obj().Type().(*types.Signature)
+		if !ok || signature.Params().Len() != 1 || signature.Results().Len() > 1 ||
+			!types.Identical(signature.Params().At(0).Type(), field.Type()) {
+			continue
+		}
+
+		withError := false
+		if signature.Results().Len() == 1 {
+			isError, err := isErrorType(signature.Results().At(0).Type())
+			if err != nil || !isError {
+				continue
+			}
+
+			withError = true
+		}
+
+		res[field.Name()] = models.Setter{
+			Name:      name,
+			WithError: withError,
+		}
+	}
+
+	if len(res) == 0 {
+		return nil
+	}
+
+	return res
+}
+
+// parseConstructor parses function like NewUser(id string, name string) (*User, [error]).
+// It returns name of created model and false if function is not constructor
+func parseConstructor(function *types.Func) (string, models.Constructor, bool) {
+	signature, ok := function.Type().(*types.Signature)
+	if !ok || signature.Variadic() || signature.TypeParams().Len() != 0 ||
+		signature.Results().Len() == 0 || signature.Results().Len() > 2 { //nolint:gomnd
+		return "", models.Constructor{}, false
+	}
+
+	withError := false
+	if signature.Results().Len() == 2 { //nolint:gomnd
+		isError, err := isErrorType(signature.Results().At(1).Type())
+		if err != nil || !isError {
+			return "", models.Constructor{}, false
+		}
+
+		withError = true
+	}
+
+	result := signature.Results().At(0).Type()
+	ptr, pointer := result.(*types.Pointer)
+	if pointer {
+		result = ptr.Elem()
+	}
+
+	named, ok := result.(*types.Named)
+	if !ok || named.Obj().Pkg() != function.Pkg() {
+		return "", models.Constructor{}, false
+	}
+
+	params := make([]models.Param, 0, signature.Params().Len())
+	for i := 0; i < signature.Params().Len(); i++ {
+		param := signature.Params().At(i)
+		if param.Name() == "" || param.Name() == "_" {
+			return "", models.Constructor{}, false
+		}
+
+		tts, err := parseType(param.Type())
+		if err != nil || len(tts) != 1 {
+			return "", models.Constructor{}, false
+		}
+
+		params = append(params, models.Param{
+			Name: param.Name(),
+			Type: tts[0].Type,
+		})
+	}
+
+	return named.Obj().Name(), models.Constructor{
+		Name:      function.Name(),
+		Params:    params,
+		Pointer:   pointer,
+		WithError: withError,
+	}, true
+}
+
+// ParseInterfaceModelsByPackage parses interface models with getters by package path or source dir
+func ParseInterfaceModelsByPackage(lg logger.Logger, source string) (map[string]models.Struct, error) {
+	dir, err := getSourceDir(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseInterfaceModels(lg, dir)
+}
+
+// ParseInterfaceModels parses interfaces with getters which are used as source models by getters
+func ParseInterfaceModels(lg logger.Logger, source string) (map[string]models.Struct, error) {
+	absSourcePath, err := filepath.Abs(source)
+	if err != nil {
+		return nil, err
+	}
+
+	pkg, err := utils.LoadPackage(lg, source)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]models.Struct)
+	for _, name := range pkg.Types.Scope().Names() {
+		obj := pkg.Types.Scope().Lookup(name)
+
+		fset := pkg.Fset.Position(obj.Pos())
+		if !strings.Contains(fset.Filename, absSourcePath) {
+			continue
+		}
+
+		currType, ok := obj.(*types.TypeName)
+		if !ok || !currType.Exported() {
+			continue
+		}
+
+		iface, ok := currType.Type().Underlying().(*types.Interface)
+		if !ok {
+			continue
+		}
+
+		model, ok := parseInterfaceModel(currType, iface, pkg.Name, pkg.PkgPath)
+		if ok {
+			res[currType.Name()] = model
+		}
+	}
+
+	return res, nil
+}
+
+// parseInterfaceModel parses interface with getters like GetName() string as source model.
+// Fields of interface model are named by getters without tags
+func parseInterfaceModel(obj *types.TypeName, iface *types.Interface, pkgName, pkgPath string) (models.Struct, bool) {
+	var fields []models.Field
+	for i := 0; i < iface.NumMethods(); i++ {
+		method := iface.Method(i)
+		if !method.Exported() || !strings.HasPrefix(method.Name(), getterPrefix) ||
+			len(method.Name()) == len(getterPrefix) {
+			continue
+		}
+
+		result, ok := getterResult(method)
+		if !ok {
+			continue
+		}
+
+		tts, err := parseType(result)
+		if err != nil || len(tts) != 1 {
+			continue
+		}
+
+		fields = append(fields, models.Field{
+			Name:   strings.TrimPrefix(method.Name(), getterPrefix),
+			Type:   tts[0].Type,
+			Getter: method.Name(),
+		})
+	}
+
+	if len(fields) == 0 {
+		return models.Struct{}, false
+	}
+
+	return models.Struct{
+		Type: models.Type{
+			Name: obj.Name(),
+			Package: models.Package{
+				Name: pkgName,
+				Path: pkgPath,
+			},
+			Kind: models.InterfaceType,
+		},
+		Fields: fields,
+	}, true
+}
+
+// getterResult returns result type of method without params and with one result
+func getterResult(obj types.Object) (types.Type, bool) {
+	signature, ok := obj.Type().(*types.Signature)
+	if !ok || signature.Params().Len() != 0 || signature.Results().Len() != 1 {
+		return nil, false
+	}
+
+	return signature.Results().At(0).Type(), true
+}
+
+// parseTypeParams returns type parameters names of generic struct. Structs with some type parameters are not supported
+func parseTypeParams(t types.Type) ([]string, bool) {
+	named, ok := t.(*types.Named)
+	if !ok || named.TypeParams().Len() == 0 {
+		return nil, true
+	}
+
+	if named.TypeParams().Len() > 1 {
+		return nil, false
+	}
+
+	return []string{named.TypeParams().At(0).Obj().Name()}, true
+}
+
+// hasValidateMethod checks that type or pointer to it has Validate() error method
+func hasValidateMethod(t types.Type) bool {
+	sel := types.NewMethodSet(types.NewPointer(t)).Lookup(nil, "Validate")
+	if sel == nil {
+		return false
+	}
+
+	signature, ok := sel.Type().(*types.Signature)
+	if !ok || signature.Params().Len() != 0 || signature.Results().Len() != 1 {
+		return false
+	}
+
+	isError, err := isErrorType(signature.Results().At(0).Type())
+	if err != nil {
+		return false
+	}
+
+	return isError
+}
@@ -70,7 +70,7 @@ func Test_ParseModelsWithFunc(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			res, err := ParseModels(lg, testPath+tt.fileName)
 			assert.NoError(t, err)
-			assert.Equal(t, tt.expected, res)
+			assert.Equal(t, tt.expected, withoutUnderlying(res))
 		})
 	}
 }
@@ -88,7 +88,8 @@ func Test_ParseModels(t *testing.T) {
 			},
 			Fields: []models.Field{
 				{Name: "ID", Type: models.Type{Name: "string"}},
-			}},
+			},
+		},
 		"TestModel": {
 			Type: models.Type{
 				Name:    "TestModel",
@@ -104,7 +105,8 @@ func Test_ParseModels(t *testing.T) {
 					{Name: "map", Value: "name"},
 				}},
 				{Name: "Empty", Type: models.Type{Name: "string"}},
-			}},
+			},
+		},
 		"TestModelTo": {
 			Type: models.Type{
 				Name:    "TestModelTo",
@@ -120,10 +122,11 @@ func Test_ParseModels(t *testing.T) {
 					{Name: "db", Value: "name"},
 					{Name: "map", Value: "name"},
 				}},
-			}},
+			},
+		},
 	}
 
-	assert.Equal(t, expected, res)
+	assert.Equal(t, expected, withoutUnderlying(res))
 }
 
 func Test_ParseComplexModel(t *testing.T) {
@@ -167,10 +170,11 @@ func Test_ParseComplexModel(t *testing.T) {
 						{Name: "map", Value: "age"},
 					},
 				},
-			}},
+			},
+		},
 	}
 
-	assert.Equal(t, expected, res)
+	assert.Equal(t, expected, withoutUnderlying(res))
 }
 
 func Test_ParseModelWithPointerField(t *testing.T) {
@@ -207,10 +211,11 @@ func Test_ParseModelWithPointerField(t *testing.T) {
 					},
 					Tags: []models.Tag{{Name: "map", Value: "age"}},
 				},
-			}},
+			},
+		},
 	}
 
-	assert.Equal(t, expected, res)
+	assert.Equal(t, expected, withoutUnderlying(res))
 }
 
 func Test_ParseModelByPackage(t *testing.T) {
@@ -348,7 +353,7 @@ func Test_ParseModelWithAlias(t *testing.T) {
 		},
 	}
 
-	assert.Equal(t, expected, res["WithAlias"])
+	assert.Equal(t, expected, withoutUnderlying(res)["WithAlias"])
 }
 
 func Test_ParseModelWithCollections(t *testing.T) {
@@ -541,7 +546,7 @@ func Test_ParseModelWithCollections(t *testing.T) {
 		},
 	}
 
-	assert.Equal(t, expected, res["ModelWithCollections"])
+	assert.Equal(t, expected, withoutUnderlying(res)["ModelWithCollections"])
 }
 
 func Test_ParseModelByBrokenPackage(t *testing.T) {
@@ -575,3 +580,159 @@ func Test_ParseModelByBrokenPackage(t *testing.T) {
 		})
 	}
 }
+
+func Test_ParseModelsWithValidate(t *testing.T) {
+	res, err := ParseModelsByPackage(logger.New(), "../_test_data/mapper/validate")
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	assert.True(t, res["Account"].WithValidate)
+	assert.False(t, res["AccountDTO"].WithValidate)
+}
+
+func Test_ParseGenericModels(t *testing.T) {
+	res, err := ParseModels(logger.New(), "../_test_data/mapper/generic")
+	require.NoError(t, err)
+
+	pkg := models.Package{
+		Name: "generic",
+		Path: "github.com/underbek/datamapper/_test_data/mapper/generic",
+	}
+
+	// structs with some type parameters are not supported
+	assert.NotContains(t, res, "Pair")
+
+	require.Contains(t, res, "Page")
+	page := res["Page"]
+	assert.Equal(t, []string{"T"}, page.TypeParams)
+
+	typeParam := models.Type{Name: "T", Kind: models.TypeParameter}
+	pointerTypeParam := typeParam
+	pointerTypeParam.Pointer = true
+
+	assert.Equal(t, []models.Field{
+		{
+			Name: "Items",
+			Type: models.Type{
+				Kind:       models.SliceType,
+				Additional: models.SliceAdditional{InType: typeParam},
+			},
+			Tags: []models.Tag{{Name: "map", Value: "items"}},
+		},
+		{
+			Name: "First",
+			Type: pointerTypeParam,
+			Tags: []models.Tag{{Name: "map", Value: "first"}},
+		},
+		{
+			Name: "Total",
+			Type: models.Type{Name: "int", Kind: models.BaseType},
+			Tags: []models.Tag{{Name: "map", Value: "total"}},
+		},
+	}, page.Fields)
+
+	require.Contains(t, res, "Users")
+	assert.Empty(t, res["Users"].TypeParams)
+	assert.Equal(t, models.Type{
+		Name:    "Page",
+		Package: pkg,
+		Kind:    models.StructType,
+		Additional: models.GenericAdditional{
+			TypeArg: models.Type{
+				Name:    "User",
+				Package: pkg,
+				Kind:    models.StructType,
+			},
+		},
+	}, res["Users"].Fields[0].Type)
+}
+
+func Test_ParseModelsWithGetters(t *testing.T) {
+	res, err := ParseModels(logger.New(), "../_test_data/mapper/getters")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"City": "GetCity", "street": "GetStreet"}, res["Address"].Getters)
+	assert.Len(t, res["User"].Getters, 4)
+	assert.Nil(t, res["UserDTO"].Getters)
+
+	// interfaces are parsed only as models with getters
+	_, ok := res["Profile"]
+	assert.False(t, ok)
+
+	res, err = ParseInterfaceModels(logger.New(), "../_test_data/mapper/getters")
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	pkg := models.Package{
+		Name: "getters",
+		Path: "github.com/underbek/datamapper/_test_data/mapper/getters",
+	}
+
+	assert.Equal(t, models.Struct{
+		Type: models.Type{
+			Name:    "Profile",
+			Package: pkg,
+			Kind:    models.InterfaceType,
+		},
+		Fields: []models.Field{
+			{
+				Name:   "FullName",
+				Type:   models.Type{Name: "string", Kind: models.BaseType},
+				Getter: "GetFullName",
+			},
+			{
+				Name:   "UserID",
+				Type:   models.Type{Name: "int64", Kind: models.BaseType},
+				Getter: "GetUserID",
+			},
+		},
+	}, res["Profile"])
+}
+
+func Test_ParseModelsWithConstructors(t *testing.T) {
+	res, err := ParseModels(logger.New(), "../_test_data/mapper/construct")
+	require.NoError(t, err)
+
+	stringType := models.Type{Name: "string", Kind: models.BaseType}
+
+	assert.Equal(t, []models.Constructor{
+		{
+			Name: "NewCustomer",
+			Params: []models.Param{
+				{Name: "id", Type: stringType},
+				{Name: "fullName", Type: stringType},
+			},
+			Pointer:   true,
+			WithError: true,
+		},
+	}, res["Customer"].Constructors)
+
+	assert.Equal(t, map[string]models.Setter{
+		"email": {Name: "SetEmail", WithError: true},
+		"age":   {Name: "SetAge"},
+	}, res["Customer"].Setters)
+
+	assert.Equal(t, []models.Constructor{
+		{
+			Name: "NewLocation",
+			Params: []models.Param{
+				{Name: "city", Type: stringType},
+				{Name: "street", Type: stringType},
+			},
+		},
+	}, res["Location"].Constructors)
+
+	assert.Nil(t, res["User"].Constructors)
+	assert.Nil(t, res["User"].Setters)
+}
+
+// withoutUnderlying clears underlying types of parsed models to compare them with expected models
+func withoutUnderlying(structs map[string]models.Struct) map[string]models.Struct {
+	res := make(map[string]models.Struct, len(structs))
+	for name, model := range structs {
+		model.Underlying = nil
+		res[name] = model
+	}
+
+	return res
+}
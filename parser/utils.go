@@ -2,9 +2,12 @@ package parser
 
 import (
 	"fmt"
+	"go/build"
 	"go/types"
+	"os"
 	"strings"
 
+	"github.com/underbek/datamapper/logger"
 	"github.com/underbek/datamapper/models"
 )
 
@@ -15,6 +18,27 @@ type Type struct {
 
 func parseType(t types.Type) ([]Type, error) {
 	switch t := t.(type) {
+	case *types.Alias:
+		// alias of redefined type like json.RawMessage = jsontext.Value keeps its own name,
+		// other aliases are parsed as their aliased types
+		named, ok := types.Unalias(t).(*types.Named)
+		if !ok || t.Obj().Pkg() == nil {
+			return parseType(types.Unalias(t))
+		}
+
+		switch named.Underlying().(type) {
+		case *types.Basic, *types.Array, *types.Slice, *types.Map:
+			return []Type{{Type: models.Type{
+				Name: t.Obj().Name(),
+				Package: models.Package{
+					Name: t.Obj().Pkg().Name(),
+					Path: t.Obj().Pkg().Path(),
+				},
+				Kind: models.RedefinedType,
+			}}}, nil
+		default:
+			return parseType(named)
+		}
 	case *types.Named:
 		switch t.Underlying().(type) {
 		case *types.Basic, *types.Array, *types.Slice, *types.Map:
@@ -27,14 +51,30 @@ func parseType(t types.Type) ([]Type, error) {
 				Kind: models.RedefinedType,
 			}}}, nil
 		case *types.Struct:
-			return []Type{{Type: models.Type{
+			res := models.Type{
 				Name: t.Obj().Name(),
 				Package: models.Package{
 					Name: t.Obj().Pkg().Name(),
 					Path: t.Obj().Pkg().Path(),
 				},
 				Kind: models.StructType,
-			}}}, nil
+			}
+
+			// only generic structs with one type parameter are supported
+			switch t.TypeArgs().Len() {
+			case 0:
+			case 1:
+				args, err := parseTypeArgument(t.TypeArgs().At(0))
+				if err != nil || len(args) != 1 {
+					return nil, err
+				}
+
+				res.Additional = models.GenericAdditional{TypeArg: args[0].Type}
+			default:
+				return nil, nil
+			}
+
+			return []Type{{Type: res}}, nil
 		default:
 			return parseType(t.Underlying())
 		}
@@ -248,3 +288,105 @@ func isErrorType(t types.Type) (bool, error) {
 
 	return true, nil
 }
+
+func isContextType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	if named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path() == "context" && named.Obj().Name() == "Context"
+}
+
+// parseTypeArgument parses type argument or type parameter of generic struct
+func parseTypeArgument(t types.Type) ([]Type, error) {
+	switch t := t.(type) {
+	case *types.TypeParam:
+		return []Type{{Type: models.Type{
+			Name: t.Obj().Name(),
+			Kind: models.TypeParameter,
+		}}}, nil
+	case *types.Pointer:
+		res, err := parseTypeArgument(t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res {
+			res[i].Pointer = true
+		}
+
+		return res, nil
+	default:
+		return parseType(t)
+	}
+}
+
+// parseFieldType parses type of struct field. Type parameters of generic struct are parsed as is
+func parseFieldType(t types.Type) ([]Type, error) {
+	switch t := t.(type) {
+	case *types.TypeParam, *types.Pointer:
+		return parseTypeArgument(t)
+	case *types.Slice:
+		inTypes, err := parseTypeArgument(t.Elem())
+		if err != nil || len(inTypes) != 1 {
+			return nil, err
+		}
+
+		return []Type{{Type: models.Type{
+			Kind: models.SliceType,
+			Additional: models.SliceAdditional{
+				InType: inTypes[0].Type,
+			},
+		}}}, nil
+	default:
+		return parseType(t)
+	}
+}
+
+// getSourceDir returns source if it exists or directory of package by its import path
+func getSourceDir(source string) (string, error) {
+	_, err := os.Stat(source)
+	if err == nil {
+		return source, nil
+	}
+
+	if !os.IsNotExist(err) {
+		return "", err
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+
+	p, err := build.Import(source, wd, build.FindOnly)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Dir, nil
+}
+
+// IsStandardPackage checks that package is a package of standard library by its location in GOROOT,
+// so user packages with paths without dots like myapp/domain are not standard.
+// Package which location is not found is not standard
+func IsStandardPackage(lg logger.Logger, path string) bool {
+	wd, err := os.Getwd()
+	if err != nil {
+		lg.Warn(fmt.Sprintf("find package %s error: %s", path, err))
+		return false
+	}
+
+	p, err := build.Import(path, wd, build.FindOnly)
+	if err != nil {
+		lg.Warn(fmt.Sprintf("find package %s error: %s", path, err))
+		return false
+	}
+
+	return p.Goroot
+}
@@ -0,0 +1,100 @@
+package parser
+
+import (
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/underbek/datamapper/logger"
+	"github.com/underbek/datamapper/models"
+)
+
+func Test_ParseAliasType(t *testing.T) {
+	jsontext := types.NewPackage("encoding/json/jsontext", "jsontext")
+	json := types.NewPackage("encoding/json", "json")
+
+	value := types.NewNamed(
+		types.NewTypeName(token.NoPos, jsontext, "Value", nil),
+		types.NewSlice(types.Typ[types.Byte]),
+		nil,
+	)
+
+	tests := []struct {
+		name     string
+		alias    types.Type
+		expected models.Type
+	}{
+		{
+			name:  "alias of named type",
+			alias: types.NewAlias(types.NewTypeName(token.NoPos, json, "RawMessage", nil), value),
+			expected: models.Type{
+				Name:    "RawMessage",
+				Package: models.Package{Name: "json", Path: "encoding/json"},
+				Kind:    models.RedefinedType,
+			},
+		},
+		{
+			name:  "alias of basic type",
+			alias: types.NewAlias(types.NewTypeName(token.NoPos, json, "String", nil), types.Typ[types.String]),
+			expected: models.Type{
+				Name: "string",
+				Kind: models.BaseType,
+			},
+		},
+		{
+			name: "alias of alias",
+			alias: types.NewAlias(
+				types.NewTypeName(token.NoPos, json, "Raw", nil),
+				types.NewAlias(types.NewTypeName(token.NoPos, json, "RawMessage", nil), value),
+			),
+			expected: models.Type{
+				Name:    "Raw",
+				Package: models.Package{Name: "json", Path: "encoding/json"},
+				Kind:    models.RedefinedType,
+			},
+		},
+		{
+			name: "alias of struct",
+			alias: types.NewAlias(
+				types.NewTypeName(token.NoPos, json, "Object", nil),
+				types.NewNamed(types.NewTypeName(token.NoPos, jsontext, "Decoder", nil), types.NewStruct(nil, nil), nil),
+			),
+			expected: models.Type{
+				Name:    "Decoder",
+				Package: models.Package{Name: "jsontext", Path: "encoding/json/jsontext"},
+				Kind:    models.StructType,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := parseType(tt.alias)
+			require.NoError(t, err)
+			require.Len(t, res, 1)
+			assert.Equal(t, tt.expected, res[0].Type)
+		})
+	}
+}
+
+func Test_IsStandardPackage(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{path: "time", expected: true},
+		{path: "encoding/json", expected: true},
+		{path: "github.com/underbek/datamapper/models"},
+		{path: "myapp/domain"},
+	}
+
+	lg := logger.New()
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsStandardPackage(lg, tt.path))
+		})
+	}
+}
@@ -14,7 +14,7 @@ var (
 
 func LoadPackage(lg logger.Logger, source string) (*packages.Package, error) {
 	cfg := &packages.Config{
-		Mode: packages.NeedName | packages.NeedTypes | packages.NeedDeps | packages.NeedImports,
+		Mode: packages.NeedName | packages.NeedTypes | packages.NeedDeps | packages.NeedImports | packages.NeedSyntax,
 	}
 
 	source = ClearFileName(source)